@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/bf"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var (
+	bfAsm   bool
+	bfDebug bool
+	bfTape  int
+)
+
+// bfCmd represents the bf command
+var bfCmd = &cobra.Command{
+	Use:   "bf [file...]",
+	Short: "Compile Brainfuck source code to bytecode",
+	Long: `Compile Brainfuck programs to bytecode images; see docs/brainfuck.md.
+The tape is a region of memory of 30000 cells, or as many as given with
+--tape. Cells hold values from 0 to 255, which wrap around, and reading
+at the end of input stores 0.
+If no files are specified, compilation reads from standard input.
+The default output filename is the input filename with '.bin' extension.
+With -S, the assembler source the program is translated to is written to
+a '.src' file instead. With -g the source line table, which refers to the
+Brainfuck source, is included in the image.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if bfTape <= 0 {
+			utils.StandardError("The tape needs at least one cell")
+		}
+
+		if len(args) == 0 {
+			bfStdin()
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					bfStdin()
+				} else {
+					bfFile(filename)
+				}
+			}
+		}
+	},
+	Aliases: []string{"smb"},
+}
+
+func init() {
+	rootCmd.AddCommand(bfCmd)
+	bfCmd.Flags().BoolVarP(&bfAsm, "asm", "S", false, "Write the translated assembler source to a .src file")
+	bfCmd.Flags().BoolVarP(&bfDebug, "debug", "g", false, "Include the source line table in the image")
+	bfCmd.Flags().IntVar(&bfTape, "tape", bf.TapeCells, "Number of cells of the tape")
+}
+
+// translateBF translates Brainfuck with the tape size given with --tape
+func translateBF(r io.Reader) (string, error) {
+	return bf.Translate(r, bfTape)
+}
+
+func bfFile(filename string) {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	outFilename := utils.GetOutputFilename(filename, frontEndExt(bfAsm))
+	compileFrontEnd(translateBF, filename, file, outFilename, bfAsm, bfDebug)
+
+	fmt.Printf("Compiled %s to %s\n", filename, outFilename)
+}
+
+func bfStdin() {
+	outFilename := "out" + frontEndExt(bfAsm)
+	compileFrontEnd(translateBF, "<stdin>", os.Stdin, outFilename, bfAsm, bfDebug)
+
+	fmt.Printf("Compiled from stdin to %s\n", outFilename)
+}
@@ -3,21 +3,53 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/objfile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/transpile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
 	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
 )
 
+var (
+	compileTarget string
+	compileRaw    bool
+	compileDebug  bool
+	compileMap    bool
+	compileObject bool
+	includeDirs   []string
+)
+
 // compileCmd represents the compile command
 var compileCmd = &cobra.Command{
 	Use:   "compile [file...]",
 	Short: "Compile stack machine source code to bytecode",
 	Long: `Compile stack machine source code to bytecode.
 If no files are specified, compilation reads from standard input.
-The default output filename is the input filename with '.bin' extension.`,
+The default output filename is the input filename with '.bin' extension.
+Images are written in the container format with a header, entry address
+and label table; --raw writes legacy headerless words instead.
+With -g the source line table is included in the image so that runtime
+errors name the source line; --map writes it, with the labels, to a '.map'
+file instead.
+With --target wat, a WebAssembly text module is written instead, with
+the '.wat' extension.
+With -c, each file is compiled to a relocatable '.o' object file with its
+labels, references to labels of other files and line table; 'smg link'
+combines object files into a program.
+Files named by include directives are looked up next to the including
+file, then in the directories given with -I and those listed in SMG_PATH.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if compileTarget != "bin" && compileTarget != "wat" {
+			utils.StandardError("Unknown target %s; use bin or wat", compileTarget)
+		}
+		if compileObject && (compileTarget != "bin" || compileRaw) {
+			utils.StandardError("Object files cannot be written with --target wat or --raw")
+		}
+
 		if len(args) == 0 {
 			compileStdin()
 		} else {
@@ -35,6 +67,77 @@ The default output filename is the input filename with '.bin' extension.`,
 
 func init() {
 	rootCmd.AddCommand(compileCmd)
+	compileCmd.Flags().StringVar(&compileTarget, "target", "bin", "Output format: bin or wat")
+	compileCmd.Flags().BoolVar(&compileRaw, "raw", false, "Write a legacy headerless image")
+	compileCmd.Flags().BoolVarP(&compileDebug, "debug", "g", false, "Include the source line table in the image")
+	compileCmd.Flags().BoolVar(&compileMap, "map", false, "Write labels and the source line table to a .map file")
+	compileCmd.Flags().BoolVarP(&compileObject, "object", "c", false, "Write a relocatable object file for smg link")
+	compileCmd.Flags().StringArrayVarP(&includeDirs, "include", "I", nil, "Search a directory for included files")
+}
+
+// includePath returns the directories searched for included files: those
+// given with -I, then those listed in SMG_PATH
+func includePath() []string {
+	dirs := append([]string{}, includeDirs...)
+	if env := os.Getenv("SMG_PATH"); env != "" {
+		dirs = append(dirs, filepath.SplitList(env)...)
+	}
+	return dirs
+}
+
+// compileExt returns the output file extension for the selected target
+func compileExt() string {
+	if compileObject {
+		return ".o"
+	}
+	return "." + compileTarget
+}
+
+// writeProgram writes the compiled program in the selected target format
+func writeProgram(c *compiler.Compiler, name string, outFile *os.File) error {
+	if compileObject {
+		obj := c.Object()
+		obj.Name = name
+		return objfile.Write(outFile, obj)
+	}
+	if compileTarget == "wat" {
+		opts := transpile.Options{Name: name, Entry: c.GetProgram().Entry()}
+		return transpile.WAT(outFile, c.GetProgram().Image(), opts)
+	}
+	if compileRaw {
+		return c.GetProgram().SaveRawImage(outFile)
+	}
+	if compileDebug {
+		c.GetProgram().SetDebug(vm.EncodeLines(c.GetProgram().Lines()))
+	}
+	return c.GetProgram().SaveImage(outFile)
+}
+
+// writeMap writes the labels and line table of the compiled program to a
+// .map file
+func writeMap(c *compiler.Compiler, mapFilename string) {
+	if !compileMap {
+		return
+	}
+
+	mapFile, err := utils.OpenFileForWriting(mapFilename)
+	if err != nil {
+		utils.StandardError("Error creating map file %s: %v", mapFilename, err)
+	}
+	defer mapFile.Close()
+
+	m := c.GetProgram()
+	if err := vm.WriteMap(mapFile, m.Labels(), m.Lines()); err != nil {
+		utils.StandardError("Error writing map file %s: %v", mapFilename, err)
+	}
+}
+
+// warningFn returns a compiler warning callback that reports warnings for
+// the named source without stopping
+func warningFn(name string) func(string) {
+	return func(msg string) {
+		fmt.Fprintf(os.Stderr, "%s: warning: %s\n", name, msg)
+	}
 }
 
 func compileFile(filename string) {
@@ -44,7 +147,7 @@ func compileFile(filename string) {
 	}
 	defer file.Close()
 
-	outFilename := utils.GetOutputFilename(filename, ".bin")
+	outFilename := utils.GetOutputFilename(filename, compileExt())
 	outFile, err := utils.OpenFileForWriting(outFilename)
 	if err != nil {
 		utils.StandardError("Error creating output file %s: %v", outFilename, err)
@@ -56,19 +159,25 @@ func compileFile(filename string) {
 	}
 
 	c := compiler.NewCompiler(compileFn)
+	c.SetFile(filename)
+	c.SetWarningCallback(warningFn(filename))
+	c.SetObject(compileObject)
+	c.SetIncludePath(includePath())
 	if err := c.CompileSource(file); err != nil {
 		utils.StandardError("Error compiling %s: %v", filename, err)
 	}
 
-	if err := c.GetProgram().SaveImage(outFile); err != nil {
+	if err := writeProgram(c, filename, outFile); err != nil {
 		utils.StandardError("Error saving compiled program: %v", err)
 	}
 
+	writeMap(c, utils.GetOutputFilename(filename, ".map"))
+
 	fmt.Printf("Compiled %s to %s\n", filename, outFilename)
 }
 
 func compileStdin() {
-	outFilename := "out.bin"
+	outFilename := "out" + compileExt()
 	outFile, err := utils.OpenFileForWriting(outFilename)
 	if err != nil {
 		utils.StandardError("Error creating output file %s: %v", outFilename, err)
@@ -80,13 +189,19 @@ func compileStdin() {
 	}
 
 	c := compiler.NewCompiler(compileFn)
+	c.SetFile("<stdin>")
+	c.SetWarningCallback(warningFn("<stdin>"))
+	c.SetObject(compileObject)
+	c.SetIncludePath(includePath())
 	if err := c.CompileSource(os.Stdin); err != nil {
 		utils.StandardError("Error compiling from stdin: %v", err)
 	}
 
-	if err := c.GetProgram().SaveImage(outFile); err != nil {
+	if err := writeProgram(c, "<stdin>", outFile); err != nil {
 		utils.StandardError("Error saving compiled program: %v", err)
 	}
 
+	writeMap(c, "out.map")
+
 	fmt.Printf("Compiled from stdin to %s\n", outFilename)
 }
@@ -2,19 +2,29 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
 	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
 	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
 )
 
+var reassemblable bool
+
 // disassembleCmd represents the disassemble command
 var disassembleCmd = &cobra.Command{
 	Use:   "disassemble [file...]",
 	Short: "Disassemble compiled bytecode",
 	Long: `Disassemble compiled bytecode to human-readable form.
+Labels from the image's symbol table, or from a map file given with --map,
+are printed before the code they name, and immediates holding a label's
+address are shown as &label.
+With --reassemblable, the output is source code that 'smg compile' turns
+back into the same image.
 If no files are specified, input is read from standard input.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
@@ -34,6 +44,8 @@ If no files are specified, input is read from standard input.`,
 
 func init() {
 	rootCmd.AddCommand(disassembleCmd)
+	disassembleCmd.Flags().StringVar(&mapFile, "map", "", "Read labels from a .map file")
+	disassembleCmd.Flags().BoolVar(&reassemblable, "reassemblable", false, "Print source code that compiles back to the same image")
 }
 
 func isPrintable(c int) bool {
@@ -53,25 +65,400 @@ func toString(c byte) string {
 	}
 }
 
+// symbols maps addresses to the labels defined there
+type symbols map[int32][]string
+
+// name returns the first label at addr
+func (s symbols) name(addr int32) (string, bool) {
+	if names := s[addr]; len(names) > 0 {
+		return names[0], true
+	}
+	return "", false
+}
+
+// defines reports whether name is a label at addr
+func (s symbols) defines(addr int32, name string) bool {
+	for _, n := range s[addr] {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// callIdiom reports whether the instructions at addr form the call
+// sequence PUSHIP return, PUSH function, JMP, returning the function address
+func callIdiom(image []int32, addr int32) (int32, bool) {
+	pushIP, ok1 := vm.Decode(image, addr)
+	push, ok2 := vm.Decode(image, pushIP.Next())
+	jmp, ok3 := vm.Decode(image, push.Next())
+	if !ok1 || !ok2 || !ok3 || pushIP.Op != vm.PUSHIP || push.Op != vm.PUSH || jmp.Op != vm.JMP {
+		return 0, false
+	}
+	if pushIP.Operand != jmp.Next() {
+		return 0, false
+	}
+	return push.Operand, true
+}
+
+// symbolTable collects the labels of the program, from the image or a map
+// file, and names every call destination that has no label
+func symbolTable(m *vm.VM, image []int32) symbols {
+	syms := make(symbols)
+	for _, label := range m.Labels() {
+		if !syms.defines(label.Pos, label.Name) {
+			syms[label.Pos] = append(syms[label.Pos], label.Name)
+		}
+	}
+
+	for addr := int32(0); vm.IsCodeAddress(image, addr); {
+		in, ok := vm.Decode(image, addr)
+		if !ok {
+			break
+		}
+
+		target, isCall := in.Operand, in.Op == vm.CALL
+		if fn, ok := callIdiom(image, addr); ok {
+			target, isCall = fn, true
+		}
+		if _, named := syms[target]; isCall && !named {
+			syms[target] = []string{fmt.Sprintf("fn_%x", target)}
+		}
+
+		addr = in.Next()
+	}
+
+	return syms
+}
+
+// printImageInfo prints the image format and entry address
+func printImageInfo(m *vm.VM) {
+	if m.ImageFormat() == 0 {
+		fmt.Printf("; Headerless image\n")
+		return
+	}
+	fmt.Printf("; Image format %d, entry 0x%x, %d labels\n", m.ImageFormat(), m.Entry(), len(m.Labels()))
+	if regions := m.Regions(); len(regions) > 1 {
+		for _, r := range regions {
+			fmt.Printf("; Section %s at 0x%x, %d bytes\n", r.Kind, r.Addr, r.Size)
+		}
+	}
+}
+
+// programWords returns the words of the program up to the end of its last
+// code or data section, including zero words that Image leaves out
+func programWords(m *vm.VM) []int32 {
+	image := m.Image()
+	for _, r := range m.Regions() {
+		if r.Kind == vm.SectionBSS {
+			continue
+		}
+		for int32(len(image)*4) < r.End() {
+			image = append(image, 0)
+		}
+	}
+	return image
+}
+
+// codeEnd returns the end of the program's code: the end of its code
+// section, or of the whole image if it has no separate data sections
+func codeEnd(m *vm.VM, image []int32) int32 {
+	if regions := m.Regions(); len(regions) > 0 && regions[0].Kind == vm.SectionCode && regions[0].Addr == 0 {
+		return regions[0].End()
+	}
+	return int32(len(image) * 4)
+}
+
+// dataRegions returns the data and bss sections of the program
+func dataRegions(m *vm.VM) []vm.Region {
+	var regions []vm.Region
+	for _, r := range m.Regions() {
+		if r.Kind == vm.SectionData || r.Kind == vm.SectionBSS {
+			regions = append(regions, r)
+		}
+	}
+	return regions
+}
+
+// spaceRuns splits a bss section into runs of zero-filled space at the
+// labels defined in it
+func (s symbols) spaceRuns(r vm.Region) [][2]int32 {
+	var runs [][2]int32
+	start := r.Addr
+	for addr := r.Addr + 4; addr < r.End(); addr += 4 {
+		if _, ok := s[addr]; ok {
+			runs = append(runs, [2]int32{start, addr - start})
+			start = addr
+		}
+	}
+	return append(runs, [2]int32{start, r.End() - start})
+}
+
+// labeledWithin reports whether a label is defined after addr and before end
+func (s symbols) labeledWithin(addr, end int32) bool {
+	for a := addr + 4; a < end; a += 4 {
+		if _, ok := s[a]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func disassemble(m *vm.VM) {
-	end := m.Size()
+	image := programWords(m)
+	syms := symbolTable(m, image)
+	end := codeEnd(m, image)
+
+	for addr := int32(0); addr < end && vm.IsCodeAddress(image, addr); {
+		for _, name := range syms[addr] {
+			fmt.Printf("%s:\n", name)
+		}
 
-	for m.Pos() <= end {
-		op := vm.Op(m.Cur())
-		fmt.Printf("0x%x %s", m.Pos(), op)
+		// PUSHIP return; PUSH function; JMP is how calls were compiled
+		// before CALL existed
+		if fn, ok := callIdiom(image, addr); ok && !syms.labeledWithin(addr, addr+20) {
+			name, named := syms.name(fn)
+			if !named {
+				name = fmt.Sprintf("0x%x", fn)
+			}
+			fmt.Printf("0x%x call %s\n", addr, name)
+			addr += 20
+			continue
+		}
 
-		if (op == vm.PUSH || op == vm.PUSHIP) && m.Pos() <= end {
-			m.Next()
-			val := m.Cur()
-			fmt.Printf(" 0x%x", val)
+		in, ok := vm.Decode(image, addr)
+		fmt.Printf("0x%x %s", addr, in.Op)
 
-			if isPrintable(int(val)) {
-				fmt.Printf(" ('%s')", toString(byte(val)))
+		if ok && in.Op.HasOperand() {
+			val := in.Operand
+			switch name, named := syms.name(val); {
+			case named && in.Op != vm.ENTER && in.Op != vm.LOADL && in.Op != vm.STORL:
+				fmt.Printf(" &%s", name)
+			case isPrintable(int(val)):
+				fmt.Printf(" 0x%x ('%s')", val, toString(byte(val)))
+			default:
+				fmt.Printf(" 0x%x", val)
 			}
 		}
 
 		fmt.Println()
-		m.Next()
+		if !ok {
+			break
+		}
+		addr = in.Next()
+	}
+
+	for _, r := range dataRegions(m) {
+		fmt.Printf("; .%s\n", r.Kind)
+		if r.Kind == vm.SectionBSS {
+			for _, run := range syms.spaceRuns(r) {
+				for _, name := range syms[run[0]] {
+					fmt.Printf("%s:\n", name)
+				}
+				fmt.Printf("0x%x .space %d\n", run[0], run[1])
+			}
+			continue
+		}
+
+		for addr := r.Addr; addr < r.End(); addr += 4 {
+			for _, name := range syms[addr] {
+				fmt.Printf("%s:\n", name)
+			}
+			fmt.Printf("0x%x .word 0x%x\n", addr, uint32(image[addr/4]))
+		}
+	}
+}
+
+// reassemblyLabels names the addresses referenced by the program. Images
+// with a symbol table keep exactly their own labels, so that compiling the
+// output reproduces the table; otherwise local labels, which the compiler
+// leaves out of the symbol table, are synthesized for call targets, jump
+// targets and data addresses found by the verifier.
+func reassemblyLabels(m *vm.VM, image []int32) symbols {
+	syms := make(symbols)
+	if len(m.Labels()) > 0 {
+		for _, label := range m.Labels() {
+			if !syms.defines(label.Pos, label.Name) {
+				syms[label.Pos] = append(syms[label.Pos], label.Name)
+			}
+		}
+		return syms
+	}
+
+	report := vm.VerifyAt(image, m.Entry())
+	for _, addr := range report.DataRefs {
+		syms[addr] = []string{fmt.Sprintf(".Ld_%x", addr)}
+	}
+	for _, addr := range report.Targets {
+		syms[addr] = []string{fmt.Sprintf(".Ll_%x", addr)}
+	}
+	for addr := int32(0); vm.IsCodeAddress(image, addr); addr += 4 {
+		if in, ok := vm.Decode(image, addr); ok && in.Op == vm.CALL && vm.IsCodeAddress(image, in.Operand) {
+			syms[in.Operand] = []string{fmt.Sprintf(".Lfn_%x", in.Operand)}
+		}
+	}
+	return syms
+}
+
+// isHaltAt reports whether the instructions at addr are the halt sequence
+// the compiler emits: PUSH of the following JMP's address, then JMP
+func isHaltAt(image []int32, addr int32) bool {
+	push, ok1 := vm.Decode(image, addr)
+	jmp, ok2 := vm.Decode(image, push.Next())
+	return ok1 && ok2 && push.Op == vm.PUSH && jmp.Op == vm.JMP && push.Operand == jmp.Addr
+}
+
+// sourceLiteral renders an immediate as a literal the compiler reads back
+// to the same word
+func sourceLiteral(val int32) string {
+	switch {
+	case val == '\n':
+		return "'\\n'"
+	case val > ' ' && val < 127 && val != '\\' && val != '\'':
+		return fmt.Sprintf("'%c'", val)
+	}
+	return fmt.Sprint(val)
+}
+
+// sourceLines returns the line table of an image with a debug section by
+// address, or nil if it has none
+func sourceLines(m *vm.VM) map[int32]vm.SourceLine {
+	if len(m.Debug()) == 0 {
+		return nil
+	}
+	lines := make(map[int32]vm.SourceLine)
+	for _, l := range m.Lines() {
+		lines[l.Addr] = l
+	}
+	return lines
+}
+
+// reassemble writes the program as source code. Compiling the output gives
+// back the same image; headerless images must be compiled with --raw, and
+// images with a debug section with -g, to compare equal.
+func reassemble(w io.Writer, m *vm.VM) {
+	image := programWords(m)
+	syms := reassemblyLabels(m, image)
+	end := codeEnd(m, image)
+	lines := sourceLines(m)
+
+	printLabels := func(addr int32) {
+		for _, name := range syms[addr] {
+			fmt.Fprintf(w, "%s:\n", name)
+		}
+	}
+	printLoc := func(addr int32) {
+		if l, ok := lines[addr]; ok {
+			fmt.Fprintf(w, "  .loc %s %d\n", compiler.Quote(l.File), l.Line)
+		}
+	}
+
+	// The compiler ends every program with a halt, so leave it out
+	stop := end
+	if _, jmpLabeled := syms[end-4]; end >= 12 && isHaltAt(image, end-12) && !jmpLabeled {
+		stop = end - 12
+	}
+
+	for addr := int32(0); addr < stop; {
+		printLabels(addr)
+		printLoc(addr)
+
+		in, ok := vm.Decode(image, addr)
+		valid := ok && in.Op >= vm.NOP && in.Op < vm.NOP_END
+
+		if valid && isHaltAt(image, addr) && syms[in.Next()] == nil {
+			fmt.Fprintf(w, "  halt\n")
+			addr += 12
+			continue
+		}
+
+		if valid && in.Op.HasOperand() {
+			name, named := syms.name(in.Operand)
+			switch in.Op {
+			case vm.ENTER, vm.LOADL, vm.STORL:
+				named = false
+			}
+			literal := sourceLiteral(in.Operand)
+
+			// Immediates that are label targets are written as raw words
+			// below
+			if syms[addr+4] == nil {
+				if named {
+					literal = "&" + name
+				}
+				fmt.Fprintf(w, "  %s %s\n", strings.ToLower(in.Op.String()), literal)
+				addr = in.Next()
+				continue
+			}
+		} else if valid {
+			fmt.Fprintf(w, "  %s\n", strings.ToLower(in.Op.String()))
+			addr = in.Next()
+			continue
+		}
+
+		if valid {
+			fmt.Fprintf(w, "  .word %d ; %s\n", image[addr/4], in.Op)
+		} else {
+			fmt.Fprintf(w, "  .word %d\n", image[addr/4])
+		}
+		addr += 4
+	}
+
+	printLabels(stop)
+	reassembleData(w, m, image, syms)
+
+	// The halt the compiler appends takes the position of the end of the
+	// source, which is only recorded in the text section
+	if _, ok := lines[stop]; ok && stop < end {
+		if len(dataRegions(m)) > 0 {
+			fmt.Fprintf(w, ".text\n")
+		}
+		printLoc(stop)
+	}
+	if stop == end {
+		fmt.Fprintf(w, ".end\n")
+	}
+}
+
+// reassembleData prints the data and bss sections of the program as .data
+// and .bss source, aligned so that they land at the same addresses
+func reassembleData(w io.Writer, m *vm.VM, image []int32, syms symbols) {
+	prevEnd := codeEnd(m, image)
+	for _, r := range dataRegions(m) {
+		fmt.Fprintf(w, ".%s\n", r.Kind)
+		if r.Addr > prevEnd {
+			// The lowest set bit is an alignment that skips the same gap
+			fmt.Fprintf(w, "  .align %d\n", r.Addr&-r.Addr)
+		}
+		prevEnd = r.End()
+
+		if r.Kind == vm.SectionBSS {
+			for _, run := range syms.spaceRuns(r) {
+				for _, name := range syms[run[0]] {
+					fmt.Fprintf(w, "%s:\n", name)
+				}
+				fmt.Fprintf(w, "  .space %d\n", run[1])
+			}
+			continue
+		}
+
+		var words []string
+		flush := func() {
+			if len(words) > 0 {
+				fmt.Fprintf(w, "  .word %s\n", strings.Join(words, " "))
+				words = words[:0]
+			}
+		}
+		for addr := r.Addr; addr < r.End(); addr += 4 {
+			if _, ok := syms[addr]; ok || len(words) == 8 {
+				flush()
+			}
+			for _, name := range syms[addr] {
+				fmt.Fprintf(w, "%s:\n", name)
+			}
+			words = append(words, fmt.Sprint(image[addr/4]))
+		}
+		flush()
 	}
 }
 
@@ -90,9 +477,15 @@ func disassembleFile(filename string) {
 	if err := m.LoadImage(file); err != nil {
 		utils.StandardError("Error loading program from %s: %v", filename, err)
 	}
+	loadMap(m)
 
 	fmt.Printf("; File %s --- %d bytes\n", filename, m.Size())
-	disassemble(m)
+	printImageInfo(m)
+	if reassemblable {
+		reassemble(os.Stdout, m)
+	} else {
+		disassemble(m)
+	}
 }
 
 func disassembleStdin() {
@@ -104,7 +497,13 @@ func disassembleStdin() {
 	if err := m.LoadImage(os.Stdin); err != nil {
 		utils.StandardError("Error loading program from stdin: %v", err)
 	}
+	loadMap(m)
 
 	fmt.Printf("; From stdin --- %d bytes\n", m.Size())
-	disassemble(m)
+	printImageInfo(m)
+	if reassemblable {
+		reassemble(os.Stdout, m)
+	} else {
+		disassemble(m)
+	}
 }
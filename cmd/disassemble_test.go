@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// imageFormat is a way smg compile writes an image
+type imageFormat int
+
+const (
+	formatImage imageFormat = iota // the container format
+	formatDebug                    // the container format with -g
+	formatRaw                      // headerless words with --raw
+)
+
+// compileImage compiles source to an image in the given format
+func compileImage(t *testing.T, name string, src io.Reader, format imageFormat) []byte {
+	t.Helper()
+
+	var errs []string
+	c := compiler.NewCompiler(func(msg string) { errs = append(errs, msg) })
+	c.SetFile(name)
+	if err := c.CompileSource(src); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("%s: %s", name, strings.Join(errs, "\n"))
+	}
+
+	var image bytes.Buffer
+	m := c.GetProgram()
+	var err error
+	switch format {
+	case formatRaw:
+		err = m.SaveRawImage(&image)
+	case formatDebug:
+		m.SetDebug(vm.EncodeLines(m.Lines()))
+		fallthrough
+	default:
+		err = m.SaveImage(&image)
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return image.Bytes()
+}
+
+// TestReassembleRoundTrip checks that compiling the reassemblable output of
+// every example program gives back the same image in each format
+func TestReassembleRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../programs/*.src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no example programs")
+	}
+
+	formats := map[string]imageFormat{"image": formatImage, "debug": formatDebug, "raw": formatRaw}
+	for _, file := range files {
+		for fname, format := range formats {
+			file, format := file, format
+			t.Run(filepath.Base(file)+"/"+fname, func(t *testing.T) {
+				src, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				image := compileImage(t, file, bytes.NewReader(src), format)
+
+				m := vm.NewMachine(func(msg string) { t.Fatal(msg) })
+				if err := m.LoadImage(bytes.NewReader(image)); err != nil {
+					t.Fatal(err)
+				}
+				var out bytes.Buffer
+				reassemble(&out, m)
+
+				again := compileImage(t, "copy.src", bytes.NewReader(out.Bytes()), format)
+				if !bytes.Equal(again, image) {
+					t.Errorf("reassembled image differs (%d bytes, was %d); source:\n%s", len(again), len(image), out.String())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/forth"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var (
+	forthAsm   bool
+	forthDebug bool
+)
+
+// forthCmd represents the forth command
+var forthCmd = &cobra.Command{
+	Use:   "forth [file...]",
+	Short: "Compile Forth source code to bytecode",
+	Long: `Compile programs written in a subset of Forth to bytecode images.
+Colon definitions, variables, constants, the usual stack, arithmetic and
+memory words, ." strings and structured control flow are supported; see
+docs/forth.md for the words.
+If no files are specified, compilation reads from standard input.
+The default output filename is the input filename with '.bin' extension.
+With -S, the assembler source the Forth code is translated to is written
+to a '.src' file instead. With -g the source line table, which refers to
+the Forth source, is included in the image.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			forthStdin()
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					forthStdin()
+				} else {
+					forthFile(filename)
+				}
+			}
+		}
+	},
+	Aliases: []string{"smf"},
+}
+
+func init() {
+	rootCmd.AddCommand(forthCmd)
+	forthCmd.Flags().BoolVarP(&forthAsm, "asm", "S", false, "Write the translated assembler source to a .src file")
+	forthCmd.Flags().BoolVarP(&forthDebug, "debug", "g", false, "Include the source line table in the image")
+}
+
+func forthFile(filename string) {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	outFilename := utils.GetOutputFilename(filename, frontEndExt(forthAsm))
+	compileFrontEnd(forth.Translate, filename, file, outFilename, forthAsm, forthDebug)
+
+	fmt.Printf("Compiled %s to %s\n", filename, outFilename)
+}
+
+func forthStdin() {
+	outFilename := "out" + frontEndExt(forthAsm)
+	compileFrontEnd(forth.Translate, "<stdin>", os.Stdin, outFilename, forthAsm, forthDebug)
+
+	fmt.Printf("Compiled from stdin to %s\n", outFilename)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"io"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+// translator translates the source of another language to assembler
+// source, one line of assembler for each line of source
+type translator func(r io.Reader) (string, error)
+
+// compileFrontEnd translates a program with a language front-end and
+// writes the compiled image, or with asm the assembler source. With debug
+// the source line table, which refers to the original source, is included
+// in the image.
+func compileFrontEnd(translate translator, name string, r io.Reader, outFilename string, asm, debug bool) {
+	src, err := translate(r)
+	if err != nil {
+		utils.StandardError("%s:%v", name, err)
+	}
+
+	outFile, err := utils.OpenFileForWriting(outFilename)
+	if err != nil {
+		utils.StandardError("Error creating output file %s: %v", outFilename, err)
+	}
+	defer outFile.Close()
+
+	if asm {
+		if _, err := io.WriteString(outFile, src); err != nil {
+			utils.StandardError("Error writing %s: %v", outFilename, err)
+		}
+		return
+	}
+
+	compileFn := func(msg string) {
+		utils.StandardError("%s:%s", name, msg)
+	}
+
+	c := compiler.NewCompiler(compileFn)
+	c.SetFile(name)
+	c.SetWarningCallback(warningFn(name))
+	if err := c.CompileSource(strings.NewReader(src)); err != nil {
+		utils.StandardError("Error compiling %s: %v", name, err)
+	}
+
+	m := c.GetProgram()
+	if debug {
+		m.SetDebug(vm.EncodeLines(m.Lines()))
+	}
+	if err := m.SaveImage(outFile); err != nil {
+		utils.StandardError("Error saving compiled program: %v", err)
+	}
+}
+
+// frontEndExt returns the output file extension of a front-end
+func frontEndExt(asm bool) string {
+	if asm {
+		return ".src"
+	}
+	return ".bin"
+}
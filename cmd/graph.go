@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/graph"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var graphCalls bool
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph [file...]",
+	Short: "Print the control-flow or call graph of a program",
+	Long: `Print the control-flow graph of a program in Graphviz DOT format.
+Files ending in '.src' are compiled first; other files are loaded as
+compiled bytecode, with labels from the image's symbol table or from a map
+file given with --map.
+Basic blocks are named by their labels, and blocks that cannot be reached
+from the entry point are drawn dashed. Jumps, branches and calls are
+followed where their target is a constant pushed in the same block.
+With --calls, the function call graph is printed instead.
+If no files are specified, bytecode is read from standard input.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			graphStdin()
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					graphStdin()
+				} else {
+					graphFile(filename)
+				}
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+	graphCmd.Flags().BoolVar(&graphCalls, "calls", false, "Print the function call graph")
+	graphCmd.Flags().StringVar(&mapFile, "map", "", "Read labels from a .map file")
+	graphCmd.Flags().StringArrayVarP(&includeDirs, "include", "I", nil, "Search a directory for included files")
+}
+
+// writeGraph prints the graph of a loaded program
+func writeGraph(m *vm.VM) {
+	g := graph.Build(m.Image(), m.Entry(), m.Labels())
+
+	var err error
+	if graphCalls {
+		err = g.WriteCallGraph(os.Stdout)
+	} else {
+		err = g.WriteCFG(os.Stdout)
+	}
+	if err != nil {
+		utils.StandardError("Error writing graph: %v", err)
+	}
+}
+
+func graphFile(filename string) {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	if filepath.Ext(filename) == ".src" {
+		errorFn := func(msg string) {
+			utils.StandardError("%s:%s", filename, msg)
+		}
+
+		c := compiler.NewCompiler(errorFn)
+		c.SetFile(filename)
+		c.SetWarningCallback(warningFn(filename))
+		c.SetIncludePath(includePath())
+		if err := c.CompileSource(file); err != nil {
+			utils.StandardError("Error compiling %s: %v", filename, err)
+		}
+		writeGraph(c.GetProgram())
+		return
+	}
+
+	m := vm.NewMachine(func(msg string) {})
+	if err := m.LoadImage(file); err != nil {
+		utils.StandardError("Error loading program from %s: %v", filename, err)
+	}
+	loadMap(m)
+	writeGraph(m)
+}
+
+func graphStdin() {
+	m := vm.NewMachine(func(msg string) {})
+	if err := m.LoadImage(os.Stdin); err != nil {
+		utils.StandardError("Error loading program from stdin: %v", err)
+	}
+	loadMap(m)
+	writeGraph(m)
+}
@@ -17,13 +17,13 @@ var interpretCmd = &cobra.Command{
 If no files are specified, input is read from standard input.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
-			interpretStdin()
+			exitOnStatus(interpretStdin())
 		} else {
 			for _, filename := range args {
 				if filename == "-" {
-					interpretStdin()
+					exitOnStatus(interpretStdin())
 				} else {
-					interpretFile(filename)
+					exitOnStatus(interpretFile(filename))
 				}
 			}
 		}
@@ -33,9 +33,11 @@ If no files are specified, input is read from standard input.`,
 
 func init() {
 	rootCmd.AddCommand(interpretCmd)
+	interpretCmd.Flags().BoolVar(&trapFaults, "trap-faults", false, "Turn runtime faults into catchable throws")
+	interpretCmd.Flags().StringArrayVarP(&includeDirs, "include", "I", nil, "Search a directory for included files")
 }
 
-func interpretFile(filename string) {
+func interpretFile(filename string) int {
 	file, err := utils.OpenFileForReading(filename)
 	if err != nil {
 		utils.StandardError("Error opening file %s: %v", filename, err)
@@ -47,22 +49,34 @@ func interpretFile(filename string) {
 	}
 
 	c := compiler.NewCompiler(errorFn)
+	c.SetFile(filename)
+	c.SetWarningCallback(warningFn(filename))
+	c.SetIncludePath(includePath())
 	if err := c.CompileSource(file); err != nil {
 		utils.StandardError("Error compiling %s: %v", filename, err)
 	}
 
-	c.GetProgram().Run(0)
+	m := c.GetProgram()
+	m.SetErrorCallback(runtimeErrorFn(filename, m))
+	m.SetTrapFaults(trapFaults)
+	return m.Run(m.Entry())
 }
 
-func interpretStdin() {
+func interpretStdin() int {
 	errorFn := func(msg string) {
 		utils.StandardError("<stdin>:%s", msg)
 	}
 
 	c := compiler.NewCompiler(errorFn)
+	c.SetFile("<stdin>")
+	c.SetWarningCallback(warningFn("<stdin>"))
+	c.SetIncludePath(includePath())
 	if err := c.CompileSource(os.Stdin); err != nil {
 		utils.StandardError("Error compiling from stdin: %v", err)
 	}
 
-	c.GetProgram().Run(0)
+	m := c.GetProgram()
+	m.SetErrorCallback(runtimeErrorFn("<stdin>", m))
+	m.SetTrapFaults(trapFaults)
+	return m.Run(m.Entry())
 }
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/linker"
+	"github.com/matt-dunleavy/stackmachine-go/internal/objfile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var (
+	linkOutput string
+	linkRaw    bool
+	linkDebug  bool
+	linkMap    bool
+)
+
+// linkCmd represents the link command
+var linkCmd = &cobra.Command{
+	Use:   "link file.o...",
+	Short: "Link object files into a program",
+	Long: `Link object files written by 'smg compile -c' into a program image.
+The code of the objects is laid out in the order given, followed by a halt,
+then their data and bss sections. Execution starts at the code of the
+first object. Labels are resolved across the objects; labels defined more
+than once and labels that are not defined are reported.
+The default output filename is the first object filename with '.bin'
+extension. --raw, -g and --map work as for 'smg compile'.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var objs []*objfile.Object
+		for _, filename := range args {
+			objs = append(objs, readObject(filename))
+		}
+
+		m, err := linker.Link(objs)
+		if err != nil {
+			utils.StandardError("Error linking: %v", err)
+		}
+
+		outFilename := linkOutput
+		if outFilename == "" {
+			outFilename = utils.GetOutputFilename(args[0], ".bin")
+		}
+		outFile, err := utils.OpenFileForWriting(outFilename)
+		if err != nil {
+			utils.StandardError("Error creating output file %s: %v", outFilename, err)
+		}
+		defer outFile.Close()
+
+		if linkRaw {
+			err = m.SaveRawImage(outFile)
+		} else {
+			if linkDebug {
+				m.SetDebug(vm.EncodeLines(m.Lines()))
+			}
+			err = m.SaveImage(outFile)
+		}
+		if err != nil {
+			utils.StandardError("Error saving linked program: %v", err)
+		}
+
+		if linkMap {
+			mapFilename := utils.GetOutputFilename(outFilename, ".map")
+			mapFile, err := utils.OpenFileForWriting(mapFilename)
+			if err != nil {
+				utils.StandardError("Error creating map file %s: %v", mapFilename, err)
+			}
+			defer mapFile.Close()
+			if err := vm.WriteMap(mapFile, m.Labels(), m.Lines()); err != nil {
+				utils.StandardError("Error writing map file %s: %v", mapFilename, err)
+			}
+		}
+
+		fmt.Printf("Linked %d object(s) to %s\n", len(objs), outFilename)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(linkCmd)
+	linkCmd.Flags().StringVarP(&linkOutput, "output", "o", "", "Output filename")
+	linkCmd.Flags().BoolVar(&linkRaw, "raw", false, "Write a legacy headerless image")
+	linkCmd.Flags().BoolVarP(&linkDebug, "debug", "g", false, "Include the source line table in the image")
+	linkCmd.Flags().BoolVar(&linkMap, "map", false, "Write labels and the source line table to a .map file")
+}
+
+// readObject reads an object file, stopping on errors
+func readObject(filename string) *objfile.Object {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	obj, err := objfile.Read(file, filename)
+	if err != nil {
+		utils.StandardError("Error reading object file %s: %v", filename, err)
+	}
+	return obj
+}
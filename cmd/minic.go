@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/minic"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var (
+	minicAsm   bool
+	minicDebug bool
+)
+
+// minicCmd represents the minic command
+var minicCmd = &cobra.Command{
+	Use:   "minic [file...]",
+	Short: "Compile MiniC source code to bytecode",
+	Long: `Compile programs written in MiniC, a small C-like language of int
+variables, functions, if, while and expressions, to bytecode images; see
+docs/minic.md for the language. The program runs main and exits with the
+value it returns.
+If no files are specified, compilation reads from standard input.
+The default output filename is the input filename with '.bin' extension.
+With -S, the assembler source the program is compiled to is written to a
+'.src' file instead. With -g the source line table, which refers to the
+MiniC source, is included in the image.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			minicStdin()
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					minicStdin()
+				} else {
+					minicFile(filename)
+				}
+			}
+		}
+	},
+	Aliases: []string{"smm"},
+}
+
+func init() {
+	rootCmd.AddCommand(minicCmd)
+	minicCmd.Flags().BoolVarP(&minicAsm, "asm", "S", false, "Write the translated assembler source to a .src file")
+	minicCmd.Flags().BoolVarP(&minicDebug, "debug", "g", false, "Include the source line table in the image")
+}
+
+func minicFile(filename string) {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	outFilename := utils.GetOutputFilename(filename, frontEndExt(minicAsm))
+	compileFrontEnd(minic.Translate, filename, file, outFilename, minicAsm, minicDebug)
+
+	fmt.Printf("Compiled %s to %s\n", filename, outFilename)
+}
+
+func minicStdin() {
+	outFilename := "out" + frontEndExt(minicAsm)
+	compileFrontEnd(minic.Translate, "<stdin>", os.Stdin, outFilename, minicAsm, minicDebug)
+
+	fmt.Printf("Compiled from stdin to %s\n", outFilename)
+}
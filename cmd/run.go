@@ -10,7 +10,17 @@ import (
 	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
 )
 
-var showHelp bool
+var (
+	showHelp   bool
+	trapFaults bool
+	recordFile string
+	replayFile string
+	verifyRun  bool
+	mapFile    string
+)
+
+// faultExitCode is the process exit status after a runtime fault
+const faultExitCode = vm.FaultStatus
 
 // runCmd represents the run command
 var runCmd = &cobra.Command{
@@ -27,15 +37,15 @@ If no files are specified, execution reads from standard input.`,
 		foundFile := false
 		for _, filename := range args {
 			if filename == "-" {
-				runStdin()
+				exitOnStatus(runStdin())
 			} else {
-				runFile(filename)
+				exitOnStatus(runFile(filename))
 				foundFile = true
 			}
 		}
 
 		if len(args) == 0 || !foundFile {
-			runStdin()
+			exitOnStatus(runStdin())
 		}
 	},
 	Aliases: []string{"smr"},
@@ -44,6 +54,11 @@ If no files are specified, execution reads from standard input.`,
 func init() {
 	rootCmd.AddCommand(runCmd)
 	runCmd.Flags().BoolVarP(&showHelp, "help", "h", false, "Show instruction set")
+	runCmd.Flags().BoolVar(&trapFaults, "trap-faults", false, "Turn runtime faults into catchable throws")
+	runCmd.Flags().StringVar(&recordFile, "record", "", "Record program input to a log file")
+	runCmd.Flags().StringVar(&replayFile, "replay", "", "Replay program input from a log file")
+	runCmd.Flags().BoolVar(&verifyRun, "verify", false, "Refuse to run images that fail verification")
+	runCmd.Flags().StringVar(&mapFile, "map", "", "Read the source line table from a .map file")
 }
 
 func printInstructions() {
@@ -59,37 +74,143 @@ func printInstructions() {
 	fmt.Printf("\nTo halt program, jump to current position:\n")
 	fmt.Printf("0x0 PUSH 0x%x\n", 4)
 	fmt.Printf("0x%x JMP\n\n", 4)
+	fmt.Printf("To halt with an exit status, push it and EXIT.\n")
+	fmt.Printf("Runtime faults exit with status %d.\n\n", faultExitCode)
 	fmt.Printf("Word size is %d bytes\n", 4)
 }
 
-func runFile(filename string) {
-	file, err := utils.OpenFileForReading(filename)
+// exitOnStatus exits the process if a program stopped with a nonzero status
+func exitOnStatus(status int) {
+	if status != 0 {
+		os.Exit(vm.ProcessStatus(status))
+	}
+}
+
+// runtimeError reports a runtime fault and exits
+func runtimeError(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(faultExitCode)
+}
+
+// runtimeErrorFn returns an error callback for m that reports runtime
+// faults at the source line of the faulting instruction when the program
+// has a line table, and under name otherwise
+func runtimeErrorFn(name string, m *vm.VM) vm.ErrorCallback {
+	return func(msg string) {
+		if loc := m.Location(m.InstrAddr()); loc != "" {
+			runtimeError("%s: %s", loc, msg)
+		}
+		runtimeError("%s:%s", name, msg)
+	}
+}
+
+// loadMap reads the labels and line table given with --map
+func loadMap(m *vm.VM) {
+	if mapFile == "" {
+		return
+	}
+
+	file, err := utils.OpenFileForReading(mapFile)
 	if err != nil {
-		utils.StandardError("Error opening file %s: %v", filename, err)
+		utils.StandardError("Error opening map file %s: %v", mapFile, err)
 	}
 	defer file.Close()
 
-	errorFn := func(msg string) {
-		fmt.Fprintf(os.Stderr, "%s\n", msg)
+	labels, lines, err := vm.ReadMap(file)
+	if err != nil {
+		utils.StandardError("Error reading map file %s: %v", mapFile, err)
+	}
+	for _, label := range labels {
+		m.AddLabel(label.Name, label.Pos)
 	}
+	m.SetLines(lines)
+}
 
-	m := vm.NewMachine(errorFn)
-	if err := m.LoadImage(file); err != nil {
-		utils.StandardError("Error loading program from %s: %v", filename, err)
+// attachInput sets up recording or replay of program input and returns a
+// function to call once the program has stopped
+func attachInput(m *vm.VM) func() {
+	if recordFile != "" && replayFile != "" {
+		utils.StandardError("--record and --replay cannot be combined")
+	}
+
+	if recordFile != "" {
+		log, err := utils.OpenFileForWriting(recordFile)
+		if err != nil {
+			utils.StandardError("Error creating input log %s: %v", recordFile, err)
+		}
+		m.SetInput(vm.NewInputRecorder(m, os.Stdin, log))
+		return func() { log.Close() }
 	}
 
-	m.Run(0)
+	if replayFile != "" {
+		log, err := utils.OpenFileForReading(replayFile)
+		if err != nil {
+			utils.StandardError("Error opening input log %s: %v", replayFile, err)
+		}
+		defer log.Close()
+
+		replayer, err := vm.NewInputReplayer(m, log)
+		if err != nil {
+			utils.StandardError("Error reading input log %s: %v", replayFile, err)
+		}
+		m.SetInput(replayer)
+		return func() {
+			if n := replayer.Remaining(); n > 0 {
+				runtimeError("replay diverged: program stopped with %d recorded inputs unread", n)
+			}
+		}
+	}
+
+	return func() {}
+}
+
+// checkImage verifies a loaded program if --verify is given
+func checkImage(name string, m *vm.VM) {
+	if !verifyRun {
+		return
+	}
+
+	report := m.Verify()
+	if !report.OK() {
+		printReport(name, report)
+		utils.StandardError("%s: refusing to run, %d problem(s) found by verifier", name, len(report.Problems))
+	}
 }
 
-func runStdin() {
-	errorFn := func(msg string) {
-		fmt.Fprintf(os.Stderr, "%s\n", msg)
+func runFile(filename string) int {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
 	}
+	defer file.Close()
 
-	m := vm.NewMachine(errorFn)
+	m := vm.NewMachine(nil)
+	m.SetErrorCallback(runtimeErrorFn(filename, m))
+	if err := m.LoadImage(file); err != nil {
+		utils.StandardError("Error loading program from %s: %v", filename, err)
+	}
+	loadMap(m)
+	checkImage(filename, m)
+	m.SetTrapFaults(trapFaults)
+
+	done := attachInput(m)
+	status := m.Run(m.Entry())
+	done()
+	return status
+}
+
+func runStdin() int {
+	m := vm.NewMachine(nil)
+	m.SetErrorCallback(runtimeErrorFn("<stdin>", m))
 	if err := m.LoadImage(os.Stdin); err != nil {
 		utils.StandardError("Error loading program from stdin: %v", err)
 	}
-
-	m.Run(0)
+	loadMap(m)
+	checkImage("<stdin>", m)
+	m.SetTrapFaults(trapFaults)
+
+	done := attachInput(m)
+	status := m.Run(m.Entry())
+	done()
+	return status
 }
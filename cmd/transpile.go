@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/transpile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+var transpileGo bool
+
+// transpileCmd represents the transpile command
+var transpileCmd = &cobra.Command{
+	Use:   "transpile --go [file...]",
+	Short: "Translate compiled bytecode to source code of another language",
+	Long: `Translate compiled bytecode ahead of time to a standalone program.
+With --go, each image becomes a Go program that behaves like running the
+image on the VM and can be built into a native binary with 'go build'.
+If no files are specified, input is read from standard input.
+The default output filename is the input filename with '.go' extension.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !transpileGo {
+			utils.StandardError("No target language selected; use --go")
+		}
+
+		if len(args) == 0 {
+			transpileStdin()
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					transpileStdin()
+				} else {
+					transpileFile(filename)
+				}
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(transpileCmd)
+	transpileCmd.Flags().BoolVar(&transpileGo, "go", false, "Generate a Go program")
+	transpileCmd.Flags().BoolVar(&trapFaults, "trap-faults", false, "Turn runtime faults into catchable throws")
+}
+
+func transpileImage(name string, r io.Reader, outFilename string) {
+	errorFn := func(msg string) {
+		fmt.Fprintf(os.Stderr, "%s\n", msg)
+	}
+
+	m := vm.NewMachine(errorFn)
+	if err := m.LoadImage(r); err != nil {
+		utils.StandardError("Error loading program from %s: %v", name, err)
+	}
+
+	outFile, err := utils.OpenFileForWriting(outFilename)
+	if err != nil {
+		utils.StandardError("Error creating output file %s: %v", outFilename, err)
+	}
+	defer outFile.Close()
+
+	opts := transpile.Options{
+		Name:       name,
+		Entry:      m.Entry(),
+		TrapFaults: trapFaults,
+	}
+	if err := transpile.Go(outFile, m.Image(), opts); err != nil {
+		utils.StandardError("Error writing %s: %v", outFilename, err)
+	}
+
+	fmt.Printf("Transpiled %s to %s\n", name, outFilename)
+}
+
+func transpileFile(filename string) {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	transpileImage(filename, file, utils.GetOutputFilename(filename, ".go"))
+}
+
+func transpileStdin() {
+	transpileImage("<stdin>", os.Stdin, "out.go")
+}
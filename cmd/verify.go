@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+	"github.com/matt-dunleavy/stackmachine-go/pkg/utils"
+)
+
+// verifyCmd represents the verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify [file...]",
+	Short: "Check compiled bytecode before running it",
+	Long: `Check compiled bytecode without running it.
+The verifier follows control flow from the entry point and reports unknown
+opcodes, truncated immediates, stack underflows and constant jump targets
+that do not land on an instruction inside the image, and code that runs
+past the end of the code. It also prints the worst-case data stack depth
+when it is known at every instruction.
+If no files are specified, input is read from standard input.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		failed := false
+		if len(args) == 0 {
+			failed = !verifyImage("<stdin>", os.Stdin)
+		} else {
+			for _, filename := range args {
+				if filename == "-" {
+					failed = !verifyImage("<stdin>", os.Stdin) || failed
+				} else {
+					failed = !verifyFile(filename) || failed
+				}
+			}
+		}
+
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+}
+
+// printReport prints the problems found in a program
+func printReport(name string, report *vm.Report) {
+	for _, p := range report.Problems {
+		fmt.Fprintf(os.Stderr, "%s:%s\n", name, p)
+	}
+}
+
+func verifyImage(name string, r io.Reader) bool {
+	m := vm.NewMachine(func(msg string) {})
+	if err := m.LoadImage(r); err != nil {
+		utils.StandardError("Error loading program from %s: %v", name, err)
+	}
+
+	report := m.Verify()
+	printReport(name, report)
+	if !report.OK() {
+		fmt.Printf("%s: %d problem(s)\n", name, len(report.Problems))
+		return false
+	}
+
+	depth := fmt.Sprintf("max stack depth %d", report.MaxDepth)
+	if !report.Static {
+		depth = "max stack depth unknown"
+	}
+	fmt.Printf("%s: ok, %d instructions, %s\n", name, report.Instructions, depth)
+	return true
+}
+
+func verifyFile(filename string) bool {
+	file, err := utils.OpenFileForReading(filename)
+	if err != nil {
+		utils.StandardError("Error opening file %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	return verifyImage(filename, file)
+}
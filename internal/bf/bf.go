@@ -0,0 +1,161 @@
+// Package bf translates Brainfuck to stack machine assembler source. Each
+// line of Brainfuck becomes one line of assembler, so the line table of
+// the compiled image refers to the Brainfuck source.
+package bf
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TapeCells is the default number of cells of the tape
+const TapeCells = 30000
+
+// OutsideTape is thrown when the current cell moves off either end of the
+// tape, as the ANS Forth code for an invalid memory address
+const OutsideTape = -9
+
+// checkWords returns the function called after each move of the current
+// cell. It throws OutsideTape unless the offset of the cell from the start
+// of the tape is not negative and the offset less the size of the tape is.
+func checkWords(cells int) []string {
+	return strings.Fields(`&bf.check.end jmp
+bf.check:
+  dup &bf.tape swap sub
+  dup ` + strconv.Itoa(4*cells) + ` swap sub
+  swap compl and -2147483648 and
+  &bf.check.ok swap jnz
+  ` + strconv.Itoa(OutsideTape) + ` throw
+bf.check.ok:
+  popip
+bf.check.end:`)
+}
+
+// loop is a [ that is not yet closed
+type loop struct {
+	n    int // Number of the loop, for its labels
+	line int
+}
+
+// Translate translates Brainfuck source to assembler source, with a tape
+// of the given number of cells.
+//
+// The address of the current cell is kept on top of the stack. Cells are
+// words of the tape in the bss section holding values from 0 to 255, which
+// wrap around. Runs of + and -, and of > and <, are combined, and [-] sets
+// the cell to zero. Loops jump to labels at both ends. Reading at the end
+// of input stores 0. Moving off the tape throws OutsideTape.
+func Translate(r io.Reader, cells int) (string, error) {
+	// The whole source is read at once, since a program may be one long
+	// line
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	var lines []string
+	if len(src) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(src), "\n"), "\n")
+	}
+
+	var out [][]string
+	var loops []loop
+	n := 0
+	moved := false
+
+	for k, text := range lines {
+		line := k + 1
+		var code []string
+		for i := 0; i < len(text); i++ {
+			switch ch := text[i]; ch {
+			case '+', '-', '>', '<':
+				// Combine a run of the same commands, and their opposites
+				count := 0
+				for ; i < len(text) && strings.IndexByte("+-><", text[i]) >= 0; i++ {
+					if text[i] != ch && text[i] != opposite(ch) {
+						break
+					}
+					if text[i] == ch {
+						count++
+					} else {
+						count--
+					}
+				}
+				i--
+				if count == 0 {
+					continue
+				}
+				if ch == '+' || ch == '-' {
+					if ch == '-' {
+						count = -count
+					}
+					code = append(code, "dup", "dup", "load", strconv.Itoa(count), "add", "255", "and", "swap", "stor")
+				} else {
+					if ch == '<' {
+						count = -count
+					}
+					code = append(code, strconv.Itoa(4*count), "add", "bf.check")
+					moved = true
+				}
+			case '.':
+				code = append(code, "dup", "load", "out")
+			case ',':
+				code = append(code, "dup", "in", "swap", "stor")
+			case '[':
+				if strings.HasPrefix(text[i:], "[-]") {
+					code = append(code, "dup", "0", "swap", "stor")
+					i += 2
+					continue
+				}
+				n++
+				loops = append(loops, loop{n: n, line: line})
+				code = append(code, "dup", "load", fmt.Sprintf("&bf.end.%d", n), "swap", "jz",
+					fmt.Sprintf("bf.loop.%d:", n))
+			case ']':
+				if len(loops) == 0 {
+					return "", fmt.Errorf("Unmatched ] at line %d", line)
+				}
+				k := loops[len(loops)-1]
+				loops = loops[:len(loops)-1]
+				code = append(code, "dup", "load", fmt.Sprintf("&bf.loop.%d", k.n), "swap", "jnz",
+					fmt.Sprintf("bf.end.%d:", k.n))
+			}
+		}
+		out = append(out, code)
+	}
+	if len(loops) > 0 {
+		return "", fmt.Errorf("[ at line %d is not closed", loops[len(loops)-1].line)
+	}
+
+	// Start at the first cell, and place the tape after the code
+	if len(out) == 0 {
+		out = append(out, nil)
+	}
+	out[0] = append([]string{"&bf.tape"}, out[0]...)
+	last := len(out) - 1
+	if moved {
+		out[last] = append(out[last], checkWords(cells)...)
+	}
+	out[last] = append(out[last], ".bss", "bf.tape:", ".space", strconv.Itoa(4*cells), ".text")
+
+	var sb strings.Builder
+	for _, code := range out {
+		sb.WriteString(strings.Join(code, " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String(), nil
+}
+
+// opposite returns the command undoing a command
+func opposite(ch byte) byte {
+	switch ch {
+	case '+':
+		return '-'
+	case '-':
+		return '+'
+	case '>':
+		return '<'
+	}
+	return '>'
+}
@@ -0,0 +1,107 @@
+package bf
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+)
+
+// fault is raised by the VM error callback to stop the machine
+type fault string
+
+// run translates and compiles a Brainfuck program and runs it with input,
+// returning its output and the runtime error that stopped it, if any
+func run(t *testing.T, name, src, input string) (output string, err fault) {
+	t.Helper()
+
+	asm, terr := Translate(strings.NewReader(src), TapeCells)
+	if terr != nil {
+		t.Fatalf("%s: %v", name, terr)
+	}
+	var errs []string
+	c := compiler.NewCompiler(func(msg string) { errs = append(errs, msg) })
+	c.SetFile(name)
+	if cerr := c.CompileSource(strings.NewReader(asm)); cerr != nil {
+		t.Fatalf("%s: %v", name, cerr)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("%s: %s", name, strings.Join(errs, "\n"))
+	}
+
+	var out bytes.Buffer
+	m := c.GetProgram()
+	m.SetOutput(&out)
+	m.SetInput(strings.NewReader(input))
+	m.SetErrorCallback(func(msg string) { panic(fault(msg)) })
+	defer func() {
+		if r := recover(); r != nil {
+			f, ok := r.(fault)
+			if !ok {
+				panic(r)
+			}
+			output, err = out.String(), f
+		}
+	}()
+	m.Run(m.Entry())
+	return out.String(), ""
+}
+
+// TestPrograms runs the programs in programs/bf and compares their output
+// with the .out files
+func TestPrograms(t *testing.T) {
+	files, err := filepath.Glob("../../programs/bf/*.bf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no Brainfuck programs")
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			base := strings.TrimSuffix(file, ".bf")
+			want, err := os.ReadFile(base + ".out")
+			if err != nil {
+				t.Fatal(err)
+			}
+			input, _ := os.ReadFile(base + ".in")
+
+			got, f := run(t, file, string(src), string(input))
+			if f != "" {
+				t.Fatalf("%s; output %q", f, got)
+			}
+			if got != string(want) {
+				t.Errorf("output is\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+// TestTape checks that moving off either end of the tape throws
+func TestTape(t *testing.T) {
+	last := strings.Repeat(">", TapeCells-1)
+	tests := []struct {
+		name, src, out string
+		err            fault
+	}{
+		{"last cell", last + "+++.", "\x03", ""},
+		{"past the end", last + ">+", "", "uncaught THROW -9"},
+		{"before the start", "+.<", "\x01", "uncaught THROW -9"},
+		{"back on the tape", "+>-<.", "\x01", ""},
+		{"one long line", strings.Repeat("+", 65) + "." + strings.Repeat("><", 50000), "A", ""},
+	}
+	for _, tt := range tests {
+		out, err := run(t, tt.name, tt.src, "")
+		if out != tt.out || err != tt.err {
+			t.Errorf("%s: output %q and error %q, want %q and %q", tt.name, out, err, tt.out, tt.err)
+		}
+	}
+}
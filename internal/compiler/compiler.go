@@ -1,40 +1,117 @@
 package compiler
 
 import (
+	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
-	"unicode"
+	"unicode/utf8"
 
+	"github.com/matt-dunleavy/stackmachine-go/internal/objfile"
 	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
 )
 
 // Compiler translates source code to machine code
 type Compiler struct {
-	machine   *vm.VM
-	vm        *vm.VM
-	forwards  []vm.Label
-	errorFunc func(string)
+	machine     *vm.VM
+	vm          *vm.VM              // Machine of the section being compiled
+	sections    []*section          // Sections in order of first use, text first
+	cur         *section            // Section being compiled
+	forwards    []reference         // Label addresses to fill in at the end
+	relocs      []reference         // Section-relative addresses to fix up at the end
+	locals      map[string]int32    // Local slots of the open locals scope
+	consts      map[string]constant // Named constants by upper case name
+	macros      map[string]*macro   // Macros by upper case name
+	included    map[string]bool     // Absolute paths of the files compiled so far
+	includePath []string            // Directories searched for included files
+	expansions  int                 // Number of macro expansions so far
+	parser      *Parser             // Parser of the token being compiled
+	file        string              // Source file name for the line table
+	lines       []vm.SourceLine     // Line table of the compiled program
+	control     []control           // Open control structures, innermost last
+	frame       *frame              // Frame of the open locals scope or DO loop
+	object      bool                // Compiling an object file for the linker
+	objRelocs   []objfile.Reloc     // Relocations of the object file
+	errorFunc   func(string)
+	warnFunc    func(string)
 }
 
 // NewCompiler creates a new compiler
 func NewCompiler(errorCallback func(string)) *Compiler {
 	machine := vm.NewMachine(errorCallback)
+	text := &section{name: ".text", vm: machine, align: 4}
 	return &Compiler{
 		machine:   machine,
 		vm:        machine,
-		forwards:  make([]vm.Label, 0),
+		sections:  []*section{text},
+		cur:       text,
+		forwards:  make([]reference, 0),
 		errorFunc: errorCallback,
 	}
 }
 
-// Error reports an error
+// Error reports an error. Errors in included files and macro expansions
+// name the position in the file and the include or expansion site.
 func (c *Compiler) Error(msg string) {
 	if c.errorFunc != nil {
-		c.errorFunc(msg)
+		c.errorFunc(msg + c.sourceContext())
 	}
 }
 
+// sourceContext describes the included file or macro expansion being
+// compiled, if any, for use in error messages
+func (c *Compiler) sourceContext() string {
+	if c.parser == nil {
+		return ""
+	}
+	if context := c.parser.Context(); context != "" {
+		return " (" + context + ")"
+	}
+	return ""
+}
+
+// SetWarningCallback sets the function called with warnings, which do not
+// stop compilation
+func (c *Compiler) SetWarningCallback(warningCallback func(string)) {
+	c.warnFunc = warningCallback
+}
+
+// Warn reports a warning
+func (c *Compiler) Warn(msg string) {
+	if c.warnFunc != nil {
+		c.warnFunc(msg)
+	}
+}
+
+// SetFile sets the source file name recorded in the line table. The file
+// counts as included, so including it again has no effect.
+func (c *Compiler) SetFile(name string) {
+	c.file = name
+	c.markIncluded(name)
+}
+
+// MarkLine records that code emitted from here on comes from line of file.
+// Only the text section has a line table.
+func (c *Compiler) MarkLine(file string, line int) {
+	if !c.inText() {
+		return
+	}
+
+	addr := c.vm.Pos()
+	if n := len(c.lines); n > 0 {
+		last := &c.lines[n-1]
+		if last.Addr == addr {
+			last.File, last.Line = file, line
+			return
+		}
+		if last.Line == line && last.File == file {
+			return
+		}
+	}
+	c.lines = append(c.lines, vm.SourceLine{Addr: addr, File: file, Line: line})
+}
+
 // IsLabel checks if a token is a label (ends with colon)
 func (c *Compiler) IsLabel(s string) bool {
 	return len(s) > 0 && s[len(s)-1] == ':'
@@ -58,47 +135,170 @@ func (c *Compiler) IsLiteral(s string) bool {
 	return c.TokenToOp(s) == vm.NOP_END
 }
 
-// IsNumber checks if a string is a number
+// IsNumber checks if a token is written as a number: a digit, optionally
+// preceded by a minus sign
 func (c *Compiler) IsNumber(s string) bool {
-	for _, r := range s {
-		if !unicode.IsDigit(r) {
-			return false
+	s = strings.TrimPrefix(s, "-")
+	return len(s) > 0 && s[0] >= '0' && s[0] <= '9'
+}
+
+// parseNumber parses a numeric literal: an optional minus sign followed by
+// decimal digits, or by hexadecimal (0x), binary (0b) or octal (0o) digits.
+// Single underscores may separate digits. Magnitudes too large for uint64
+// are returned as the extreme int64 values so that range checks fail.
+func parseNumber(s string) (int64, bool) {
+	neg := strings.HasPrefix(s, "-")
+	digits := strings.ToLower(strings.TrimPrefix(s, "-"))
+
+	base := 10
+	if len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x':
+			base = 16
+		case 'b':
+			base = 2
+		case 'o':
+			base = 8
+		}
+		if base != 10 {
+			digits = digits[2:]
 		}
 	}
-	return len(s) > 0
-}
 
-// IsChar checks if a token represents a character literal
-func (c *Compiler) IsChar(s string) bool {
-	if len(s) == 3 && s[0] == '\'' && s[2] == '\'' && s[1] != '\\' {
-		return true
+	if digits == "" || digits[0] == '_' || digits[len(digits)-1] == '_' ||
+		strings.Contains(digits, "__") {
+		return 0, false
 	}
-	if len(s) == 4 && s[0] == '\'' && s[3] == '\'' && s[1] == '\\' &&
-		(s[2] == 't' || s[2] == 'r' || s[2] == 'n' || s[2] == '0') {
-		return true
+
+	val, err := strconv.ParseUint(strings.ReplaceAll(digits, "_", ""), base, 64)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Err != strconv.ErrRange {
+			return 0, false
+		}
+		val = math.MaxUint64
 	}
-	return false
-}
 
-// ToOrd converts a character literal to its ASCII value
-func (c *Compiler) ToOrd(s string) int32 {
-	if len(s) == 3 { // 'x'
-		return int32(s[1])
+	switch {
+	case val > math.MaxInt64 && neg:
+		return math.MinInt64, true
+	case val > math.MaxInt64:
+		return math.MaxInt64, true
+	case neg:
+		return -int64(val), true
 	}
-	if len(s) == 4 { // '\x'
-		switch s[2] {
+	return int64(val), true
+}
+
+// unescape decodes the characters of a character or string literal,
+// translating the escapes \t, \r, \n, \0, \\, \', \" and \xHH
+func unescape(s string) ([]int32, bool) {
+	var chars []int32
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		if r == utf8.RuneError && size <= 1 {
+			return nil, false
+		}
+		s = s[size:]
+		if r != '\\' {
+			chars = append(chars, r)
+			continue
+		}
+
+		if len(s) == 0 {
+			return nil, false
+		}
+		switch s[0] {
 		case 't':
-			return int32('\t')
+			r = '\t'
 		case 'r':
-			return int32('\r')
+			r = '\r'
 		case 'n':
-			return int32('\n')
+			r = '\n'
 		case '0':
-			return int32(0)
+			r = 0
+		case '\\', '\'', '"':
+			r = rune(s[0])
+		case 'x':
+			if len(s) < 3 {
+				return nil, false
+			}
+			val, err := strconv.ParseUint(s[1:3], 16, 8)
+			if err != nil {
+				return nil, false
+			}
+			r = rune(val)
+			s = s[2:]
+		default:
+			return nil, false
+		}
+		chars = append(chars, r)
+		s = s[1:]
+	}
+	return chars, true
+}
+
+// Quote writes text as a string literal of the source language, escaping
+// the characters that would end the literal or start a comment
+func Quote(text string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(text); i++ {
+		switch ch := text[i]; {
+		case ch == '"' || ch == '\\' || ch == ';' || ch < ' ':
+			fmt.Fprintf(&sb, "\\x%02x", ch)
+		default:
+			sb.WriteByte(ch)
 		}
 	}
-	c.Error("Unknown character literal: " + s)
-	return 0
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// parseChar parses a character literal: a single character or escape in
+// single quotes
+func parseChar(s string) (int32, bool) {
+	if len(s) < 3 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return 0, false
+	}
+	chars, ok := unescape(s[1 : len(s)-1])
+	if !ok || len(chars) != 1 {
+		return 0, false
+	}
+	return chars[0], true
+}
+
+// IsString checks if a token is a string literal (starts with a double
+// quote)
+func (c *Compiler) IsString(s string) bool {
+	return len(s) > 0 && s[0] == '"'
+}
+
+// ToChars converts a string literal to its character codes
+func (c *Compiler) ToChars(s string) []int32 {
+	if len(s) < 2 || s[len(s)-1] != '"' {
+		c.Error("Malformed string literal: " + s)
+		return nil
+	}
+	chars, ok := unescape(s[1 : len(s)-1])
+	if !ok {
+		c.Error("Malformed string literal: " + s)
+	}
+	return chars
+}
+
+// IsChar checks if a token represents a character literal
+func (c *Compiler) IsChar(s string) bool {
+	_, ok := parseChar(s)
+	return ok
+}
+
+// ToOrd converts a character literal to its character code
+func (c *Compiler) ToOrd(s string) int32 {
+	val, ok := parseChar(s)
+	if !ok {
+		c.Error("Unknown character literal: " + s)
+	}
+	return val
 }
 
 // IsLabelRef checks if a token is a label reference (starts with &)
@@ -106,16 +306,23 @@ func (c *Compiler) IsLabelRef(s string) bool {
 	return len(s) > 0 && s[0] == '&'
 }
 
-// ToLiteral converts a string to a numeric literal
-func (c *Compiler) ToLiteral(s string) int32 {
+// ToLiteral converts a numeric or character literal to its value. It
+// returns false if the token is neither; numbers that are malformed or do
+// not fit in a signed word are reported as errors.
+func (c *Compiler) ToLiteral(s string) (int32, bool) {
 	if c.IsNumber(s) {
-		val, _ := strconv.Atoi(s)
-		return int32(val)
+		val, ok := parseNumber(s)
+		if !ok {
+			c.Error("Invalid number: " + s)
+		} else if val < math.MinInt32 || val > math.MaxInt32 {
+			c.Error("Number out of range: " + s)
+		}
+		return int32(val), true
 	}
-	if c.IsChar(s) {
-		return c.ToOrd(s)
+	if len(s) >= 3 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return c.ToOrd(s), true
 	}
-	return -1
+	return 0, false
 }
 
 // IsHalt checks if a token represents a halt instruction
@@ -132,47 +339,224 @@ func (c *Compiler) CheckLabelName(label string) {
 
 // CompileLabel compiles a label reference
 func (c *Compiler) CompileLabel(label string) {
-	address := c.vm.GetLabelAddress(label)
-
 	c.vm.Load(vm.PUSH)
+	c.CompileAddress(label)
+}
+
+// CompileAddress compiles the address of a label as a raw word
+func (c *Compiler) CompileAddress(label string) {
+	c.compileValue("&"+label, math.MinInt32, math.MaxInt32, "Number out of range: ")
+}
 
-	// If label not found, mark it for update
-	if address == -1 {
-		c.CheckLabelName(label)
-		c.forwards = append(c.forwards, vm.NewLabel(label, c.vm.Pos()))
+// CompileOperand compiles the immediate word following an instruction
+// such as PUSH or TRY, taken from the next token
+func (c *Compiler) CompileOperand(op vm.Op, p *Parser) error {
+	token, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return err
 	}
 
-	c.vm.LoadInt(address)
+	if !c.IsExpression(token) {
+		c.Error("Invalid operand for " + op.String() + ": " + token)
+	}
+
+	c.compileValue(token, math.MinInt32, math.MaxInt32, "Number out of range: ")
+	return nil
 }
 
 // CompileFunctionCall compiles a function call
 func (c *Compiler) CompileFunctionCall(function string) {
-	// Return address is here plus four instructions
-	c.vm.Load(vm.PUSHIP)
-	c.vm.LoadInt(c.vm.Pos() + 4*c.vm.WordSize())
+	// Call function destination address -- update it later
+	c.vm.Load(vm.CALL)
+	c.forwards = append(c.forwards, reference{
+		Label:   vm.NewLabel(function, c.vm.Pos()),
+		sec:     c.cur,
+		context: c.sourceContext(),
+	})
+	c.vm.LoadInt(-1) // Just use an arbitrary number
+}
 
-	// Push function destination address -- update it later
-	c.vm.Load(vm.PUSH)
-	c.forwards = append(c.forwards, vm.NewLabel(function, c.vm.Pos()))
-	c.vm.LoadInt(-1) // Just push an arbitrary number
+// IsWordDirective checks if a token emits a raw word
+func (c *Compiler) IsWordDirective(s string) bool {
+	return strings.ToLower(s) == ".word"
+}
+
+// IsLocDirective checks if a token sets the source position of the code
+// that follows
+func (c *Compiler) IsLocDirective(s string) bool {
+	return strings.ToLower(s) == ".loc"
+}
+
+// CompileLoc compiles a .loc directive, which records the code that
+// follows in the line table as coming from the file and line given by the
+// next two tokens, until the next .loc directive
+func (c *Compiler) CompileLoc(p *Parser) error {
+	file, err := p.NextToken()
+	if err != nil {
+		return err
+	}
+	line, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	n, ok := parseNumber(line)
+	if !c.IsString(file) || !ok || n < 1 {
+		c.Error("Expected a file name in double quotes and a line number after .loc")
+		return nil
+	}
+	p.SetPosition(string(runes(c.ToChars(file))), int(n))
+	return nil
+}
+
+// IsLocalLabel checks if a label is local to the source. Local labels
+// start with .L and are left out of the labels of the compiled program.
+func IsLocalLabel(name string) bool {
+	return len(name) > 2 && name[0] == '.' && (name[1] == 'L' || name[1] == 'l')
+}
+
+// dropLocalLabels removes local labels once references to them have been
+// resolved
+func (c *Compiler) dropLocalLabels() {
+	var labels []vm.Label
+	for _, label := range c.machine.Labels() {
+		if !IsLocalLabel(label.Name) {
+			labels = append(labels, label)
+		}
+	}
+	c.machine.SetLabels(labels)
+}
+
+// IsEndDirective checks if a token ends the source without a final halt
+func (c *Compiler) IsEndDirective(s string) bool {
+	return strings.ToLower(s) == ".end"
+}
+
+// CompileWord compiles a .word directive, emitting each value on the rest
+// of the line as a raw word
+func (c *Compiler) CompileWord(p *Parser) error {
+	tokens, err := p.LineTokens()
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		c.Error("Missing value for .word")
+	}
+
+	for _, token := range tokens {
+		c.compileWordValue(".word", token)
+	}
+	return nil
+}
+
+// compileWordValue emits a token as a raw word: an expression with a value
+// between -2147483648 and 4294967295
+func (c *Compiler) compileWordValue(directive, token string) {
+	if !c.IsExpression(token) {
+		c.Error("Invalid value for " + directive + ": " + token)
+	}
+
+	c.compileValue(token, math.MinInt32, math.MaxUint32, "Invalid value for "+directive+": ")
+}
+
+// IsEmbedDirective checks if a token embeds data in the image
+func (c *Compiler) IsEmbedDirective(s string) bool {
+	return strings.ToUpper(s) == "EMBED"
+}
+
+// CompileEmbed compiles an embed directive. A string is placed in the image
+// one character per word, followed by a zero word; any other value is
+// placed as a single word, as with .word.
+func (c *Compiler) CompileEmbed(p *Parser) error {
+	token, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if !c.IsString(token) {
+		c.compileWordValue("embed", token)
+		return nil
+	}
+
+	for _, ch := range c.ToChars(token) {
+		c.vm.LoadInt(ch)
+	}
+	c.vm.LoadInt(0)
+	return nil
+}
+
+// IsLocalsDecl checks if a token declares frame-local variables
+func (c *Compiler) IsLocalsDecl(s string) bool {
+	return strings.ToUpper(s) == "LOCALS"
+}
+
+// IsEndLocals checks if a token closes the open locals scope
+func (c *Compiler) IsEndLocals(s string) bool {
+	return strings.ToUpper(s) == "ENDLOCALS"
+}
+
+// CompileLocals compiles a locals declaration. The names on the rest of the
+// line are bound to slots of a new frame until the next declaration or
+// ENDLOCALS.
+func (c *Compiler) CompileLocals(p *Parser) error {
+	names, err := p.LineTokens()
+	if err != nil {
+		return err
+	}
+	if k := c.loop(0); k != nil {
+		c.Error(fmt.Sprintf("Locals declared inside the DO loop at %s", k.where))
+	}
+
+	c.locals = make(map[string]int32, len(names))
+	for i, name := range names {
+		if !c.IsLiteral(name) || c.IsLabelRef(name) || c.IsNumber(name) ||
+			c.IsChar(name) || strings.HasSuffix(name, "!") {
+			c.Error("Invalid local name: " + name)
+		}
+		key := strings.ToUpper(name)
+		if _, ok := c.locals[key]; ok {
+			c.Error("Duplicate local name: " + name)
+		}
+		c.locals[key] = int32(i)
+	}
 
-	// Jump to function
-	c.vm.Load(vm.JMP)
+	c.vm.Load(vm.ENTER)
+	c.frame = &frame{sec: c.cur, pos: c.vm.Pos(), base: int32(len(names)), size: int32(len(names))}
+	c.vm.LoadInt(int32(len(names)))
+	return nil
+}
+
+// CompileLocal compiles a read or a write (name!) of a local variable.
+// Returns false if the token does not name a local.
+func (c *Compiler) CompileLocal(token string) bool {
+	op := vm.LOADL
+	name := token
+	if strings.HasSuffix(name, "!") {
+		op = vm.STORL
+		name = name[:len(name)-1]
+	}
+
+	slot, ok := c.locals[strings.ToUpper(name)]
+	if !ok {
+		return false
+	}
+
+	c.vm.Load(op)
+	c.vm.LoadInt(slot)
+	return true
 }
 
 // CompileLiteral compiles a literal value
 func (c *Compiler) CompileLiteral(token string) {
-	if c.IsLabelRef(token) {
-		c.CompileLabel(token[1:])
+	// Names in the open locals scope are frame slots
+	if c.CompileLocal(token) {
 		return
 	}
 
-	literal := c.ToLiteral(token)
-
-	// Literals are pushed onto the stack
-	if literal != -1 {
+	// Literals and other expressions are pushed onto the stack
+	if c.IsExpression(token) {
 		c.vm.Load(vm.PUSH)
-		c.vm.LoadInt(literal)
+		c.compileValue(token, math.MinInt32, math.MaxInt32, "Number out of range: ")
 		return
 	}
 
@@ -180,34 +564,133 @@ func (c *Compiler) CompileLiteral(token string) {
 	c.CompileFunctionCall(token)
 }
 
-// ResolveForwards resolves forward references
+// ResolveForwards lays out the sections and resolves forward references
 func (c *Compiler) ResolveForwards() {
+	c.layoutSections()
+	defer c.dropLocalLabels()
+	if c.object {
+		c.resolveObject()
+		return
+	}
+
 	for _, forward := range c.forwards {
-		address := c.vm.GetLabelAddress(forward.Name)
+		if forward.expr != "" {
+			c.machine.SetMem(forward.sec.base+forward.Pos, c.resolveValue(forward))
+			continue
+		}
 
+		address := c.machine.GetLabelAddress(forward.Name)
 		if address == -1 {
-			c.Error("Code label not found: " + forward.Name)
+			c.Error("Code label not found: " + forward.Name + forward.context)
 		}
 
 		// Update label jump to address
-		c.vm.SetMem(forward.Pos, address)
+		c.machine.SetMem(forward.sec.base+forward.Pos, address)
 	}
 }
 
+// LoadHalt compiles a halt sequence in the current section
+func (c *Compiler) LoadHalt() {
+	c.relocate(c.vm.Pos() + 4)
+	c.vm.LoadHalt()
+}
+
 // CompileToken compiles a single token
 // Returns false when compilation is finished
 func (c *Compiler) CompileToken(s string, p *Parser) (bool, error) {
+	c.parser = p
+	c.MarkLine(p.Position())
+
 	if s == "" {
-		c.vm.LoadHalt()
+		// The program ends with a halt after the code; the linker adds it
+		// after the code of the entry object
+		c.CheckControl()
+		c.SwitchSection(".text")
+		if !c.object {
+			c.LoadHalt()
+		}
 		c.ResolveForwards()
 		return false, nil
 	} else if c.IsHalt(s) {
-		c.vm.LoadHalt()
+		c.LoadHalt()
+	} else if c.IsSectionDirective(s) {
+		c.SwitchSection(s)
+	} else if c.IsSpaceDirective(s) {
+		if err := c.CompileSpace(p); err != nil {
+			return false, err
+		}
+	} else if c.IsAlignDirective(s) {
+		if err := c.CompileAlign(p); err != nil {
+			return false, err
+		}
+	} else if c.IsEndDirective(s) {
+		c.CheckControl()
+		c.ResolveForwards()
+		return false, nil
+	} else if c.IsWordDirective(s) {
+		if err := c.CompileWord(p); err != nil {
+			return false, err
+		}
+	} else if c.IsLocDirective(s) {
+		if err := c.CompileLoc(p); err != nil {
+			return false, err
+		}
+	} else if c.IsIncludeDirective(s) {
+		if err := c.CompileInclude(p); err != nil {
+			return false, err
+		}
+	} else if c.IsImportDirective(s) {
+		if err := c.CompileImport(p); err != nil {
+			return false, err
+		}
+	} else if c.IsEmbedDirective(s) {
+		if err := c.CompileEmbed(p); err != nil {
+			return false, err
+		}
+	} else if c.IsString(s) {
+		c.Error("String literal outside embed: " + s)
 	} else if c.IsComment(s) {
 		p.SkipLine()
+	} else if c.IsLocalsDecl(s) {
+		if err := c.CompileLocals(p); err != nil {
+			return false, err
+		}
+	} else if c.IsEndLocals(s) {
+		if k := c.loop(0); k != nil && !k.owner {
+			c.Error(fmt.Sprintf("ENDLOCALS inside the DO loop at %s", k.where))
+		}
+		c.locals = nil
+		c.frame = nil
+	} else if c.IsControlWord(s) {
+		c.CompileControl(s, p)
+	} else if c.IsMacroDirective(s) {
+		if err := c.CompileMacro(p); err != nil {
+			return false, err
+		}
+	} else if c.IsEndMacroDirective(s) {
+		c.Error("Unexpected .endm outside a macro definition")
+	} else if m := c.lookupMacro(s); m != nil {
+		if err := c.ExpandMacro(m, p); err != nil {
+			return false, err
+		}
+	} else if c.IsConstDirective(s) {
+		if err := c.CompileConst(p); err != nil {
+			return false, err
+		}
+	} else if c.IsLiteral(s) && !c.IsLabelRef(s) && p.PeekToken() == "=" {
+		p.NextToken()
+		if err := c.DefineConst(s, p); err != nil {
+			return false, err
+		}
 	} else if c.IsLiteral(s) {
 		c.CompileLiteral(s)
 	} else if c.IsLabel(s) {
+		name := s[:len(s)-1]
+		c.CheckLabelName(name)
+		if c.IsNumber(name) {
+			c.Warn(fmt.Sprintf("%s: label %s looks like a number; %s now pushes the number "+
+				"instead of calling the label, so rename it or reference it as &%s", p.Where(), name, name, name))
+		}
 		c.vm.AddLabel(s, c.vm.Pos())
 	} else {
 		op := c.TokenToOp(s)
@@ -217,6 +700,12 @@ func (c *Compiler) CompileToken(s string, p *Parser) (bool, error) {
 		}
 
 		c.vm.Load(op)
+
+		if op.HasOperand() {
+			if err := c.CompileOperand(op, p); err != nil {
+				return false, err
+			}
+		}
 	}
 
 	return true, nil
@@ -230,6 +719,7 @@ func (c *Compiler) GetProgram() *vm.VM {
 // CompileSource compiles source code from a reader
 func (c *Compiler) CompileSource(r io.Reader) error {
 	parser := NewParser(r)
+	parser.file = c.file
 
 	for {
 		token, err := parser.NextToken()
@@ -247,5 +737,6 @@ func (c *Compiler) CompileSource(r io.Reader) error {
 		}
 	}
 
+	c.machine.SetLines(c.lines)
 	return nil
 }
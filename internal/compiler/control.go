@@ -0,0 +1,262 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// control is a structure opened by IF, ELSE, BEGIN, WHILE or DO and not
+// yet closed
+type control struct {
+	word  string   // Upper case word that opened the structure
+	sec   *section // Section the structure is compiled in
+	begin int32    // Address jumped back to by UNTIL, REPEAT and LOOP
+	patch int32    // Position of the address to fill in where it ends
+	slot  int32    // First of the index and limit slots of a DO loop
+	owner bool     // The DO loop opened the frame of its slots
+	where string   // Position of the word, for messages
+}
+
+// frame is the frame of local slots being compiled into: the frame of a
+// locals declaration, or one opened by a DO loop outside of any
+type frame struct {
+	sec  *section
+	pos  int32 // Position of the slot count of the ENTER instruction
+	base int32 // First slot free for DO loops
+	size int32 // Number of slots
+}
+
+// IsControlWord checks if a token is a structured control flow word. I and
+// J are control words only inside DO loops.
+func (c *Compiler) IsControlWord(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IF", "ELSE", "THEN", "BEGIN", "UNTIL", "WHILE", "REPEAT", "DO", "LOOP":
+		return true
+	case "I":
+		return c.loopDepth() > 0
+	case "J":
+		return c.loopDepth() > 1
+	}
+	return false
+}
+
+// isReservedWord checks if a token is a control word in every context
+func isReservedWord(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IF", "ELSE", "THEN", "BEGIN", "UNTIL", "WHILE", "REPEAT", "DO", "LOOP":
+		return true
+	}
+	return false
+}
+
+// CompileControl compiles a structured control flow word. Structures
+// compile to jumps to addresses within the code, without labels.
+func (c *Compiler) CompileControl(s string, p *Parser) {
+	word := strings.ToUpper(s)
+	switch word {
+	case "IF":
+		// ( flag -- ) skip to ELSE or THEN if flag is zero
+		patch := c.loadJump(vm.JZ, 0)
+		c.open(word, p, 0).patch = patch
+	case "ELSE":
+		if k := c.close(word, p, "IF"); k != nil {
+			patch := c.loadJump(vm.JMP, 0)
+			c.resolveJump(k.patch)
+			c.open(word, p, 0).patch = patch
+		}
+	case "THEN":
+		if k := c.close(word, p, "IF", "ELSE"); k != nil {
+			c.resolveJump(k.patch)
+		}
+	case "BEGIN":
+		c.open(word, p, c.vm.Pos())
+	case "UNTIL":
+		// ( flag -- ) repeat from BEGIN while flag is zero
+		if k := c.close(word, p, "BEGIN"); k != nil {
+			c.loadJump(vm.JZ, k.begin)
+		}
+	case "WHILE":
+		// ( flag -- ) leave the loop after REPEAT if flag is zero
+		if k := c.close(word, p, "BEGIN"); k != nil {
+			patch := c.loadJump(vm.JZ, 0)
+			c.open(word, p, k.begin).patch = patch
+		}
+	case "REPEAT":
+		if k := c.close(word, p, "WHILE"); k != nil {
+			c.loadJump(vm.JMP, k.begin)
+			c.resolveJump(k.patch)
+		}
+	case "DO":
+		c.compileDo(p)
+	case "LOOP":
+		c.compileLoop(p)
+	case "I":
+		c.loadSlot(vm.LOADL, c.loop(0).slot)
+	case "J":
+		c.loadSlot(vm.LOADL, c.loop(1).slot)
+	}
+}
+
+// open starts a structure at the current position
+func (c *Compiler) open(word string, p *Parser, begin int32) *control {
+	c.control = append(c.control, control{word: word, sec: c.cur, begin: begin, where: p.Where()})
+	return &c.control[len(c.control)-1]
+}
+
+// close ends the innermost structure, which must have been opened by one
+// of the given words. Returns nil after reporting an error if it was not.
+func (c *Compiler) close(word string, p *Parser, openers ...string) *control {
+	if len(c.control) == 0 {
+		c.Error(fmt.Sprintf("%s without %s%s", word, strings.Join(openers, " or "), at(p)))
+		return nil
+	}
+
+	k := c.control[len(c.control)-1]
+	matched := false
+	for _, opener := range openers {
+		matched = matched || k.word == opener
+	}
+	if !matched {
+		c.Error(fmt.Sprintf("%s%s does not match %s at %s", word, at(p), k.word, k.where))
+		return nil
+	}
+	if k.sec != c.cur {
+		c.Error(fmt.Sprintf("%s%s is in another section than %s at %s", word, at(p), k.word, k.where))
+		return nil
+	}
+
+	c.control = c.control[:len(c.control)-1]
+	return &k
+}
+
+// at names the position of the last token for messages, unless the
+// message gets the position in an included file or macro as its context
+func at(p *Parser) string {
+	if p.Context() != "" {
+		return ""
+	}
+	return " at " + p.Where()
+}
+
+// CheckControl reports a structure that is still open at the end of the
+// source
+func (c *Compiler) CheckControl() {
+	if n := len(c.control); n > 0 {
+		k := c.control[n-1]
+		c.Error(fmt.Sprintf("%s at %s is not closed", k.word, k.where))
+		c.control = nil
+	}
+}
+
+// loadJump compiles a jump to addr, a position in the current section,
+// and returns the position of the address. JZ takes the flag from the
+// stack.
+func (c *Compiler) loadJump(op vm.Op, addr int32) int32 {
+	c.vm.Load(vm.PUSH)
+	pos := c.vm.Pos()
+	c.relocate(pos)
+	c.vm.LoadInt(addr)
+	if op == vm.JZ {
+		c.vm.Load(vm.SWAP)
+	}
+	c.vm.Load(op)
+	return pos
+}
+
+// resolveJump makes the jump whose address is at pos go to the current
+// position
+func (c *Compiler) resolveJump(pos int32) {
+	c.vm.SetMem(pos, c.vm.Pos())
+}
+
+// loadSlot compiles an instruction on a local slot
+func (c *Compiler) loadSlot(op vm.Op, slot int32) {
+	c.vm.Load(op)
+	c.vm.LoadInt(slot)
+}
+
+// loopDepth returns the number of open DO loops
+func (c *Compiler) loopDepth() int {
+	depth := 0
+	for _, k := range c.control {
+		if k.word == "DO" {
+			depth++
+		}
+	}
+	return depth
+}
+
+// loop returns the DO loop n levels out from the innermost
+func (c *Compiler) loop(n int) *control {
+	for i := len(c.control) - 1; i >= 0; i-- {
+		if c.control[i].word == "DO" {
+			if n == 0 {
+				return &c.control[i]
+			}
+			n--
+		}
+	}
+	return nil
+}
+
+// compileDo compiles DO, which runs the body up to LOOP for each index
+// from start up to but not including limit. The index and limit are kept
+// in two local slots, added to the frame of the enclosing locals
+// declaration, or else to a frame opened by the outermost loop.
+func (c *Compiler) compileDo(p *Parser) {
+	// ( limit start -- )
+	owner := c.frame == nil
+	if owner {
+		c.vm.Load(vm.ENTER)
+		c.frame = &frame{sec: c.cur, pos: c.vm.Pos()}
+		c.vm.LoadInt(0)
+	}
+
+	slot := c.frame.base
+	if outer := c.loop(0); outer != nil {
+		slot = outer.slot + 2
+	}
+	if slot+2 > c.frame.size {
+		c.frame.size = slot + 2
+		c.frame.sec.vm.SetMem(c.frame.pos, c.frame.size)
+	}
+
+	c.loadSlot(vm.STORL, slot)
+	c.loadSlot(vm.STORL, slot+1)
+
+	// Leave the loop when the index reaches the limit
+	k := c.open("DO", p, c.vm.Pos())
+	k.slot, k.owner = slot, owner
+	c.vm.Load(vm.PUSH)
+	k.patch = c.vm.Pos()
+	c.relocate(k.patch)
+	c.vm.LoadInt(0)
+	c.loadSlot(vm.LOADL, slot)
+	c.loadSlot(vm.LOADL, slot+1)
+	c.vm.Load(vm.XOR)
+	c.vm.Load(vm.JZ)
+}
+
+// compileLoop compiles LOOP, which increments the index of the innermost
+// DO loop and repeats it
+func (c *Compiler) compileLoop(p *Parser) {
+	k := c.close("LOOP", p, "DO")
+	if k == nil {
+		return
+	}
+
+	c.loadSlot(vm.LOADL, k.slot)
+	c.vm.Load(vm.PUSH)
+	c.vm.LoadInt(1)
+	c.vm.Load(vm.ADD)
+	c.loadSlot(vm.STORL, k.slot)
+	c.loadJump(vm.JMP, k.begin)
+	c.resolveJump(k.patch)
+
+	if k.owner {
+		c.vm.Load(vm.LEAVE)
+		c.frame = nil
+	}
+}
@@ -0,0 +1,474 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// constant is a name defined with .const or NAME = expr. Its expression is
+// evaluated where it is used, with HERE standing for the address it was
+// defined at.
+type constant struct {
+	expr string
+	sec  *section
+	pos  int32
+}
+
+// errDeferred is returned when an expression needs label addresses or HERE
+// before the sections have been laid out
+var errDeferred = errors.New("expression depends on addresses")
+
+// evaluator evaluates an expression by recursive descent. Operators and
+// their precedence follow C, from lowest: |, ^, &, << >>, + -, * / %, and
+// the unary operators - ~ +. Operands are numbers, character literals,
+// constants, HERE, &label and parenthesized expressions.
+type evaluator struct {
+	c       *Compiler
+	s       string
+	i       int
+	here    int32    // Address HERE stands for
+	hereSec *section // Section HERE is in
+	final   bool     // Sections are laid out and label addresses are known
+	depth   int      // Nesting of constants being evaluated
+
+	// To find which addresses an object file must relocate, addresses in a
+	// section can be moved by bias, and labels that are not defined take
+	// the value extern. The name of such a label is recorded in external.
+	bias     map[*section]int64
+	extern   int64
+	external string
+}
+
+// operatorChars are the characters that can follow an operand
+const operatorChars = "+-*/%&|^<>)"
+
+func (e *evaluator) skipSpace() {
+	for e.i < len(e.s) && (e.s[e.i] == ' ' || e.s[e.i] == '\t') {
+		e.i++
+	}
+}
+
+// accept consumes op if it comes next
+func (e *evaluator) accept(op string) bool {
+	e.skipSpace()
+	if strings.HasPrefix(e.s[e.i:], op) {
+		e.i += len(op)
+		return true
+	}
+	return false
+}
+
+// binary parses a left-associative level of binary operators
+func (e *evaluator) binary(ops []string, next func() (int64, error), apply func(op string, a, b int64) (int64, error)) (int64, error) {
+	val, err := next()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		e.skipSpace()
+		op := ""
+		for _, o := range ops {
+			if strings.HasPrefix(e.s[e.i:], o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return val, nil
+		}
+		e.i += len(op)
+
+		rhs, err := next()
+		if err != nil {
+			return 0, err
+		}
+		if val, err = apply(op, val, rhs); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (e *evaluator) expr() (int64, error) {
+	return e.binary([]string{"|"}, e.xor, applyBinary)
+}
+
+func (e *evaluator) xor() (int64, error) {
+	return e.binary([]string{"^"}, e.and, applyBinary)
+}
+
+func (e *evaluator) and() (int64, error) {
+	return e.binary([]string{"&"}, e.shift, applyBinary)
+}
+
+func (e *evaluator) shift() (int64, error) {
+	return e.binary([]string{"<<", ">>"}, e.sum, applyBinary)
+}
+
+func (e *evaluator) sum() (int64, error) {
+	return e.binary([]string{"+", "-"}, e.product, applyBinary)
+}
+
+func (e *evaluator) product() (int64, error) {
+	return e.binary([]string{"*", "/", "%"}, e.unary, applyBinary)
+}
+
+// applyBinary applies a binary operator
+func applyBinary(op string, a, b int64) (int64, error) {
+	switch op {
+	case "|":
+		return a | b, nil
+	case "^":
+		return a ^ b, nil
+	case "&":
+		return a & b, nil
+	case "<<", ">>":
+		if b < 0 || b > 63 {
+			return 0, fmt.Errorf("Shift count out of range: %d", b)
+		}
+		if op == "<<" {
+			return a << uint(b), nil
+		}
+		return a >> uint(b), nil
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	}
+
+	if b == 0 {
+		return 0, errors.New("Division by zero")
+	}
+	if op == "/" {
+		return a / b, nil
+	}
+	return a % b, nil
+}
+
+func (e *evaluator) unary() (int64, error) {
+	switch {
+	case e.accept("-"):
+		val, err := e.unary()
+		return -val, err
+	case e.accept("~"):
+		val, err := e.unary()
+		return ^val, err
+	case e.accept("+"):
+		return e.unary()
+	}
+	return e.operand()
+}
+
+// operand parses a number, character literal, label address, constant,
+// HERE or parenthesized expression
+func (e *evaluator) operand() (int64, error) {
+	e.skipSpace()
+	if e.i >= len(e.s) {
+		return 0, errors.New("Missing operand")
+	}
+	rest := e.s[e.i:]
+
+	switch {
+	case rest[0] == '(':
+		e.i++
+		val, err := e.expr()
+		if err != nil {
+			return 0, err
+		}
+		if !e.accept(")") {
+			return 0, errors.New("Missing )")
+		}
+		return val, nil
+
+	case rest[0] >= '0' && rest[0] <= '9':
+		n := 1
+		for n < len(rest) && isNumberChar(rest[n]) {
+			n++
+		}
+		e.i += n
+		val, ok := parseNumber(rest[:n])
+		if !ok {
+			return 0, fmt.Errorf("Invalid number: %s", rest[:n])
+		}
+		return val, nil
+
+	case rest[0] == '\'':
+		// The shortest quoted run that is a valid character literal
+		for n := 3; n <= len(rest); n++ {
+			if val, ok := parseChar(rest[:n]); ok {
+				e.i += n
+				return int64(val), nil
+			}
+		}
+		return 0, fmt.Errorf("Unknown character literal: %s", rest)
+
+	case rest[0] == '&':
+		e.i++
+		return e.label()
+	}
+
+	return e.name()
+}
+
+// isNumberChar reports whether b can continue a number, including prefixes,
+// hexadecimal digits and separators
+func isNumberChar(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b == '_'
+}
+
+// nameEnd returns the length of the longest name at the start of s, which
+// runs up to whitespace or a parenthesis
+func nameEnd(s string) int {
+	n := 0
+	for n < len(s) && !strings.ContainsRune(" \t()", rune(s[n])) {
+		n++
+	}
+	return n
+}
+
+// label parses the label of a &label operand. Label names may contain
+// operator characters, so the longest prefix that names a label is used.
+func (e *evaluator) label() (int64, error) {
+	rest := e.s[e.i:]
+	end := nameEnd(rest)
+	if !e.final {
+		e.i += end
+		return 0, errDeferred
+	}
+
+	for n := end; n > 0; n-- {
+		name := rest[:n]
+		if strings.ToUpper(name) == "HERE" {
+			e.i += n
+			return int64(e.here) + e.bias[e.hereSec], nil
+		}
+		if addr, ok := e.c.labelAddress(name); ok {
+			e.i += n
+			return int64(addr) + e.bias[e.c.labelSection(name)], nil
+		}
+	}
+
+	// Labels of other object files are resolved by the linker
+	if e.c.object && end > 0 {
+		name := e.c.externalName(rest[:end])
+		end = len(name)
+		if e.external != "" && !strings.EqualFold(e.external, name) {
+			return 0, fmt.Errorf("Expression uses more than one external label: %s", e.s)
+		}
+		e.external = name
+		e.i += end
+		return e.extern, nil
+	}
+	return 0, fmt.Errorf("Code label not found: %s", rest[:end])
+}
+
+// externalName returns the label of a &label operand that is not defined
+// in an object file. It is the shortest prefix followed by an operator and
+// a valid rest of the expression, or the whole operand, so that both
+// &ext+4 and &print-exit name the expected label.
+func (c *Compiler) externalName(s string) string {
+	for n := 1; n < len(s); n++ {
+		if !strings.ContainsRune(operatorChars, rune(s[n])) {
+			continue
+		}
+		tail := &evaluator{c: c, s: "0" + s[n:]}
+		if _, err := tail.eval(); err == nil || err == errDeferred {
+			return s[:n]
+		}
+	}
+	return s
+}
+
+// name parses a constant or HERE, using the longest prefix that names one
+func (e *evaluator) name() (int64, error) {
+	rest := e.s[e.i:]
+	for n := nameEnd(rest); n > 0; n-- {
+		name := strings.ToUpper(rest[:n])
+		if name == "HERE" {
+			e.i += n
+			if !e.final {
+				return 0, errDeferred
+			}
+			return int64(e.here) + e.bias[e.hereSec], nil
+		}
+		if k, ok := e.c.consts[name]; ok {
+			e.i += n
+			return e.constant(k)
+		}
+	}
+	return 0, fmt.Errorf("Unknown name in expression: %s", rest[:nameEnd(rest)])
+}
+
+// constant evaluates the expression of a constant
+func (e *evaluator) constant(k constant) (int64, error) {
+	if e.depth > 100 {
+		return 0, errors.New("Constants nested too deeply")
+	}
+
+	inner := &evaluator{c: e.c, s: k.expr, final: e.final, depth: e.depth + 1, bias: e.bias, extern: e.extern}
+	if e.final {
+		inner.here, inner.hereSec = k.sec.base+k.pos, k.sec
+	}
+	val, err := inner.eval()
+	if inner.external != "" {
+		if e.external != "" && !strings.EqualFold(e.external, inner.external) {
+			return 0, fmt.Errorf("Expression uses more than one external label: %s", e.s)
+		}
+		e.external = inner.external
+	}
+	return val, err
+}
+
+// eval evaluates the whole expression
+func (e *evaluator) eval() (int64, error) {
+	val, err := e.expr()
+	if err != nil {
+		return 0, err
+	}
+	if e.skipSpace(); e.i < len(e.s) {
+		return 0, fmt.Errorf("Unexpected %q in expression", e.s[e.i:])
+	}
+	return val, nil
+}
+
+// labelAddress returns the address of a label, without treating HERE
+// specially
+func (c *Compiler) labelAddress(name string) (int32, bool) {
+	for _, label := range c.machine.Labels() {
+		if strings.EqualFold(label.Name, name) {
+			return label.Pos, true
+		}
+	}
+	return 0, false
+}
+
+// Evaluate evaluates an expression that does not depend on addresses
+func (c *Compiler) Evaluate(expr string) (int64, error) {
+	e := &evaluator{c: c, s: expr}
+	val, err := e.eval()
+	if err == errDeferred {
+		return 0, fmt.Errorf("Not a constant expression: %s", expr)
+	}
+	return val, err
+}
+
+// IsExpression checks if a token is a value to compute: a number,
+// character literal, label reference, parenthesized expression, or an
+// expression starting with a constant or HERE, optionally after - or ~.
+// A leading + is not an operator here, as in labels such as +1.
+func (c *Compiler) IsExpression(s string) bool {
+	if s == "" {
+		return false
+	}
+	if c.IsNumber(s) || c.IsLabelRef(s) || strings.ContainsRune("'(", rune(s[0])) {
+		return true
+	}
+	if strings.ContainsRune("-~", rune(s[0])) {
+		return c.IsExpression(s[1:])
+	}
+
+	for n := nameEnd(s); n > 0; n-- {
+		name := strings.ToUpper(s[:n])
+		if _, ok := c.consts[name]; ok || name == "HERE" {
+			return n == len(s) || strings.ContainsRune(operatorChars, rune(s[n]))
+		}
+	}
+	return false
+}
+
+// compileValue emits the word an expression evaluates to. Expressions that
+// use label addresses or HERE are filled in once the sections have been
+// laid out. Values outside min and max are reported with rangeMsg.
+func (c *Compiler) compileValue(expr string, min, max int64, rangeMsg string) {
+	e := &evaluator{c: c, s: expr}
+	val, err := e.eval()
+	switch {
+	case err == errDeferred:
+		c.forwards = append(c.forwards, reference{
+			Label:   vm.NewLabel("", c.vm.Pos()),
+			sec:     c.cur,
+			expr:    expr,
+			min:     min,
+			max:     max,
+			msg:     rangeMsg,
+			context: c.sourceContext(),
+		})
+		val = -1 // Placeholder
+	case err != nil:
+		c.Error(err.Error())
+	case val < min || val > max:
+		c.Error(rangeMsg + expr)
+	}
+
+	c.vm.LoadInt(int32(val))
+}
+
+// resolveValue evaluates a deferred expression once the sections are laid
+// out
+func (c *Compiler) resolveValue(ref reference) int32 {
+	e := &evaluator{c: c, s: ref.expr, here: ref.sec.base + ref.Pos, hereSec: ref.sec, final: true}
+	val, err := e.eval()
+	if err != nil {
+		c.Error(err.Error() + ref.context)
+	} else if val < ref.min || val > ref.max {
+		c.Error(ref.msg + ref.expr + ref.context)
+	}
+	return int32(val)
+}
+
+// IsConstDirective checks if a token defines a named constant
+func (c *Compiler) IsConstDirective(s string) bool {
+	return strings.ToLower(s) == ".const"
+}
+
+// CompileConst compiles a .const directive: a name followed by an
+// expression on the rest of the line
+func (c *Compiler) CompileConst(p *Parser) error {
+	name, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if p.eol {
+		c.Error("Missing value for constant: " + name)
+		return nil
+	}
+	return c.DefineConst(name, p)
+}
+
+// DefineConst defines a constant named name as the expression on the rest
+// of the line
+func (c *Compiler) DefineConst(name string, p *Parser) error {
+	tokens, err := p.LineTokens()
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToUpper(name)
+	_, exists := c.consts[key]
+	switch {
+	case len(tokens) == 0:
+		c.Error("Missing value for constant: " + name)
+	case !c.IsLiteral(name) || c.IsNumber(name) || c.IsLabelRef(name) || c.IsString(name) ||
+		strings.ContainsRune("'(-+~", rune(name[0])) || key == "HERE":
+		c.Error("Invalid constant name: " + name)
+	case exists:
+		c.Error("Duplicate constant: " + name)
+	}
+
+	expr := strings.Join(tokens, " ")
+	if _, err := (&evaluator{c: c, s: expr}).eval(); err != nil && err != errDeferred {
+		c.Error(err.Error())
+	}
+
+	if c.consts == nil {
+		c.consts = make(map[string]constant)
+	}
+	c.consts[key] = constant{expr: expr, sec: c.cur, pos: c.vm.Pos()}
+	return nil
+}
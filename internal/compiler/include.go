@@ -0,0 +1,145 @@
+package compiler
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/stdlib"
+)
+
+// IsIncludeDirective checks if a token includes another source file
+func (c *Compiler) IsIncludeDirective(s string) bool {
+	return strings.ToLower(s) == "include"
+}
+
+// SetIncludePath sets the directories searched for included files that
+// are not found next to the including file
+func (c *Compiler) SetIncludePath(dirs []string) {
+	c.includePath = dirs
+}
+
+// markIncluded records that a file has been compiled and reports whether
+// it had been already
+func (c *Compiler) markIncluded(path string) bool {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		path = real
+	}
+
+	if c.included == nil {
+		c.included = make(map[string]bool)
+	}
+	if c.included[path] {
+		return true
+	}
+	c.included[path] = true
+	return false
+}
+
+// findInclude returns the path of an included file: relative to the
+// directory of the including file, or else to a directory of the include
+// path. Absolute names are used as they are.
+func (c *Compiler) findInclude(name, from string) (string, bool) {
+	if filepath.IsAbs(name) {
+		_, err := os.Stat(name)
+		return name, err == nil
+	}
+
+	dir := "."
+	if from != "" && from != "<stdin>" {
+		dir = filepath.Dir(from)
+	}
+	for _, d := range append([]string{dir}, c.includePath...) {
+		path := filepath.Join(d, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// CompileInclude compiles an include directive, which reads the file named
+// by the following string literal in place of the directive. Each file is
+// included only once; later includes of the same file are ignored.
+func (c *Compiler) CompileInclude(p *Parser) error {
+	token, err := p.NextToken()
+	if err != nil {
+		return err
+	}
+	if !c.IsString(token) {
+		c.Error("Expected a file name in double quotes after include: " + token)
+		return nil
+	}
+
+	name := string(runes(c.ToChars(token)))
+	path, ok := c.findInclude(name, p.File())
+	if !ok {
+		c.Error("Include file not found: " + name)
+		return nil
+	}
+	if c.markIncluded(path) {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		c.Error("Error reading include file " + path + ": " + err.Error())
+		return nil
+	}
+
+	// The included file must end its last line, so that its last token is
+	// not joined with the next token of the including file
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data, '\n')
+	}
+	p.Include(path, bytes.NewReader(data))
+	return nil
+}
+
+// IsImportDirective checks if a token imports a module of the standard
+// library
+func (c *Compiler) IsImportDirective(s string) bool {
+	return strings.ToLower(s) == "import"
+}
+
+// CompileImport compiles an import directive, which reads the standard
+// library module named by the following token in place of the directive.
+// Like included files, each module is compiled only once.
+func (c *Compiler) CompileImport(p *Parser) error {
+	name, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	data, ok := stdlib.Source(strings.ToLower(name))
+	if !ok {
+		c.Error("Unknown library module: " + name + " (available: " + strings.Join(stdlib.Modules(), ", ") + ")")
+		return nil
+	}
+
+	path := "<std>/" + strings.ToLower(name) + ".src"
+	if c.included == nil {
+		c.included = make(map[string]bool)
+	}
+	if c.included[path] {
+		return nil
+	}
+	c.included[path] = true
+
+	p.Include(path, bytes.NewReader(data))
+	return nil
+}
+
+// runes converts character codes to runes
+func runes(chars []int32) []rune {
+	r := make([]rune, len(chars))
+	for i, ch := range chars {
+		r[i] = rune(ch)
+	}
+	return r
+}
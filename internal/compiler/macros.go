@@ -0,0 +1,209 @@
+package compiler
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxExpansionDepth limits how deeply macro expansions may nest, so that a
+// macro that expands itself is reported instead of expanding forever
+const maxExpansionDepth = 64
+
+// macro is a sequence of source lines compiled in place of its name, with
+// its parameters replaced by the arguments of each expansion
+type macro struct {
+	name   string
+	params []string
+	body   [][]string      // Tokens of each line of the body
+	file   string          // File that defines the macro
+	line   int             // Line of the .macro directive
+	labels map[string]bool // Labels defined in the body, by upper case name
+}
+
+// IsMacroDirective checks if a token starts a macro definition
+func (c *Compiler) IsMacroDirective(s string) bool {
+	return strings.ToLower(s) == ".macro"
+}
+
+// IsEndMacroDirective checks if a token ends a macro definition
+func (c *Compiler) IsEndMacroDirective(s string) bool {
+	return strings.ToLower(s) == ".endm"
+}
+
+// isMacroName checks if a token can name a macro or one of its parameters
+func (c *Compiler) isMacroName(s string) bool {
+	return c.IsLiteral(s) && !c.IsLabelRef(s) && !c.IsNumber(s) && !c.IsString(s) &&
+		!strings.ContainsAny(s[:1], ".';") && strings.ToUpper(s) != "HERE" && !isReservedWord(s)
+}
+
+// CompileMacro compiles a macro definition: .macro followed by the name
+// and parameters of the macro, then the lines of its body up to .endm
+func (c *Compiler) CompileMacro(p *Parser) error {
+	line := p.TokenLine()
+	tokens, err := p.LineTokens()
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		c.Error("Missing name for .macro")
+		return nil
+	}
+
+	m := &macro{
+		name:   tokens[0],
+		params: tokens[1:],
+		file:   p.File(),
+		line:   line,
+		labels: make(map[string]bool),
+	}
+	if !c.isMacroName(m.name) {
+		c.Error("Invalid macro name: " + m.name)
+	}
+	if _, ok := c.macros[strings.ToUpper(m.name)]; ok {
+		c.Error("Duplicate macro: " + m.name)
+	}
+	seen := make(map[string]bool)
+	for _, param := range m.params {
+		if !c.isMacroName(param) {
+			c.Error("Invalid parameter name for macro " + m.name + ": " + param)
+		}
+		if seen[strings.ToUpper(param)] {
+			c.Error("Duplicate parameter name for macro " + m.name + ": " + param)
+		}
+		seen[strings.ToUpper(param)] = true
+	}
+
+	for {
+		lineNo := p.GetLineNo()
+		text, err := p.ReadLine()
+		if err == io.EOF {
+			c.Error(fmt.Sprintf("Missing .endm for macro %s defined at %s", m.name, p.Where()))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		tokens, err := splitTokens(text, lineNo)
+		if err != nil {
+			return err
+		}
+		if len(tokens) > 0 && c.IsEndMacroDirective(tokens[0]) {
+			break
+		}
+		if len(tokens) > 0 && c.IsMacroDirective(tokens[0]) {
+			c.Error(fmt.Sprintf("Nested macro definition in macro %s defined at %s", m.name, p.Where()))
+		}
+
+		for _, token := range tokens {
+			if c.IsLabel(token) {
+				m.labels[strings.ToUpper(token[:len(token)-1])] = true
+			}
+		}
+		m.body = append(m.body, tokens)
+	}
+
+	if c.macros == nil {
+		c.macros = make(map[string]*macro)
+	}
+	c.macros[strings.ToUpper(m.name)] = m
+	return nil
+}
+
+// lookupMacro returns the macro a token names, or nil
+func (c *Compiler) lookupMacro(s string) *macro {
+	return c.macros[strings.ToUpper(s)]
+}
+
+// ExpandMacro compiles an expansion of a macro. Its arguments are the
+// tokens that follow on the same line, one per parameter, and the body is
+// read by the parser before the rest of the line.
+func (c *Compiler) ExpandMacro(m *macro, p *Parser) error {
+	args := make([]string, 0, len(m.params))
+	for len(args) < len(m.params) && !p.eol {
+		arg, err := p.NextToken()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if c.IsComment(arg) {
+			if err := p.SkipLine(); err != nil && err != io.EOF {
+				return err
+			}
+			break
+		}
+		if arg == "" {
+			break
+		}
+		args = append(args, arg)
+	}
+
+	if len(args) != len(m.params) {
+		c.Error(fmt.Sprintf("Macro %s defined at %s expects %d argument(s), got %d",
+			m.name, p.where(m.file, m.line), len(m.params), len(args)))
+		return nil
+	}
+	if p.ExpansionDepth() >= maxExpansionDepth {
+		c.Error(fmt.Sprintf("Macro expansion nested more than %d deep: %s", maxExpansionDepth, m.name))
+		return nil
+	}
+
+	subst := make(map[string]string, len(args))
+	for i, param := range m.params {
+		subst[strings.ToUpper(param)] = args[i]
+	}
+
+	// Labels of the body get a suffix unique to this expansion
+	c.expansions++
+	suffix := fmt.Sprintf("@%d", c.expansions)
+
+	var text strings.Builder
+	for _, line := range m.body {
+		for i, token := range line {
+			if i > 0 {
+				text.WriteByte(' ')
+			}
+			text.WriteString(m.expandToken(token, subst, suffix))
+		}
+		text.WriteByte('\n')
+	}
+
+	p.Expand(m.name, m.file, m.line, text.String())
+	return nil
+}
+
+// expandToken replaces a parameter with its argument and renames labels of
+// the body, in definitions, references and calls
+func (m *macro) expandToken(token string, subst map[string]string, suffix string) string {
+	if arg, ok := subst[strings.ToUpper(token)]; ok {
+		return arg
+	}
+
+	if strings.HasSuffix(token, ":") && m.labels[strings.ToUpper(token[:len(token)-1])] {
+		return token[:len(token)-1] + suffix + ":"
+	}
+
+	if strings.HasPrefix(token, "&") {
+		name := token[1:]
+		// &param takes the address of the label passed as the argument
+		if arg, ok := subst[strings.ToUpper(name)]; ok {
+			if strings.HasPrefix(arg, "&") {
+				return arg
+			}
+			return "&" + arg
+		}
+		// The longest label of the body that starts an expression
+		for n := len(name); n > 0; n-- {
+			if m.labels[strings.ToUpper(name[:n])] &&
+				(n == len(name) || strings.ContainsRune(operatorChars, rune(name[n]))) {
+				return "&" + name[:n] + suffix + name[n:]
+			}
+		}
+		return token
+	}
+
+	if m.labels[strings.ToUpper(token)] {
+		return token + suffix
+	}
+	return token
+}
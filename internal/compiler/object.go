@@ -0,0 +1,176 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/objfile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// probe is the distance sections and external labels are moved by to find
+// out how an expression depends on them
+const probe = 1 << 20
+
+// SetObject makes the compiler produce a relocatable object file for the
+// linker: no halt is compiled at the end of the source, labels that are not
+// defined are left to the linker, and every word holding an address is
+// recorded as a relocation. The linker adds a halt after the code of the
+// entry object.
+func (c *Compiler) SetObject(object bool) {
+	c.object = object
+}
+
+// kind returns the image section kind of a section
+func (s *section) kind() vm.SectionKind {
+	switch s.name {
+	case ".data":
+		return vm.SectionData
+	case ".bss":
+		return vm.SectionBSS
+	}
+	return vm.SectionCode
+}
+
+// labelSection returns the section a label is defined in
+func (c *Compiler) labelSection(name string) *section {
+	for _, sec := range c.sections[1:] {
+		for _, label := range sec.vm.Labels() {
+			if strings.EqualFold(label.Name, name) {
+				return sec
+			}
+		}
+	}
+	return c.sections[0]
+}
+
+// addReloc records that the word at pos in sec holds an address into
+// target, or of the label symbol if target is nil. The word is changed to
+// the offset from the start of the target, or to the amount added to the
+// label.
+func (c *Compiler) addReloc(sec *section, pos int32, target *section, symbol string) {
+	r := objfile.Reloc{Section: sec.kind(), Offset: pos, Symbol: symbol}
+	if target != nil {
+		r.Target = target.kind()
+		addr := sec.base + pos
+		c.machine.SetMem(addr, c.machine.GetMem(addr)-target.base)
+	}
+	c.objRelocs = append(c.objRelocs, r)
+}
+
+// resolveObject fills in the forward references of an object file and
+// records its relocations, once the sections have been laid out
+func (c *Compiler) resolveObject() {
+	for _, r := range c.relocs {
+		c.addReloc(r.sec, r.Pos, r.sec, "")
+	}
+
+	for _, forward := range c.forwards {
+		addr := forward.sec.base + forward.Pos
+		if forward.expr != "" {
+			c.resolveObjectValue(forward)
+			continue
+		}
+
+		if pos, ok := c.labelAddress(forward.Name); ok {
+			c.machine.SetMem(addr, pos)
+			c.addReloc(forward.sec, forward.Pos, c.labelSection(forward.Name), "")
+		} else {
+			c.machine.SetMem(addr, 0)
+			c.addReloc(forward.sec, forward.Pos, nil, forward.Name)
+		}
+	}
+}
+
+// resolveObjectValue fills in a deferred expression of an object file. An
+// expression that depends on addresses must be an address in one section,
+// or an external label, plus a constant; the expression is evaluated with
+// each section and the external label moved to find out which.
+func (c *Compiler) resolveObjectValue(ref reference) {
+	eval := func(bias map[*section]int64, extern int64) (int64, string, error) {
+		e := &evaluator{
+			c:       c,
+			s:       ref.expr,
+			here:    ref.sec.base + ref.Pos,
+			hereSec: ref.sec,
+			final:   true,
+			bias:    bias,
+			extern:  extern,
+		}
+		val, err := e.eval()
+		return val, e.external, err
+	}
+
+	val, external, err := eval(nil, 0)
+	if err != nil {
+		c.Error(err.Error() + ref.context)
+		return
+	}
+
+	var target *section
+	moves := 0
+	for _, sec := range c.sections {
+		moved, _, _ := eval(map[*section]int64{sec: probe}, 0)
+		switch moved - val {
+		case 0:
+		case probe:
+			target = sec
+			moves++
+		default:
+			moves += 2
+		}
+	}
+	if external != "" {
+		moved, _, _ := eval(nil, probe)
+		if moved-val != probe {
+			moves += 2
+		}
+		moves++
+	}
+
+	switch {
+	case moves > 1:
+		c.Error(fmt.Sprintf("Expression is not an address plus a constant: %s%s", ref.expr, ref.context))
+	case moves == 0 && (val < ref.min || val > ref.max):
+		c.Error(ref.msg + ref.expr + ref.context)
+	}
+
+	c.machine.SetMem(ref.sec.base+ref.Pos, int32(val))
+	if target != nil || external != "" {
+		c.addReloc(ref.sec, ref.Pos, target, external)
+	}
+}
+
+// Object returns the compiled object file, after compiling in object mode
+func (c *Compiler) Object() *objfile.Object {
+	obj := &objfile.Object{Name: c.file, Lines: c.lines, Relocs: c.objRelocs}
+
+	for _, name := range sectionOrder {
+		for _, sec := range c.sections {
+			if sec.name != name {
+				continue
+			}
+
+			s := objfile.Section{Kind: sec.kind(), Align: sec.align}
+			size := sec.vm.Pos()
+			if s.Kind == vm.SectionBSS {
+				s.Size = size
+			} else {
+				for off := int32(0); off < size; off += 4 {
+					s.Words = append(s.Words, c.machine.GetMem(sec.base+off))
+				}
+			}
+			obj.Sections = append(obj.Sections, s)
+		}
+	}
+
+	for _, label := range c.machine.Labels() {
+		sec := c.labelSection(label.Name)
+		obj.Symbols = append(obj.Symbols, objfile.Symbol{
+			Name:    label.Name,
+			Section: sec.kind(),
+			Offset:  label.Pos - sec.base,
+		})
+	}
+	return obj
+}
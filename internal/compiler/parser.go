@@ -3,14 +3,33 @@ package compiler
 import (
 	"bufio"
 	"bytes"
+	"fmt"
 	"io"
+	"strings"
 	"unicode"
 )
 
 // Parser parses source code for the stack machine
 type Parser struct {
-	reader *bufio.Reader
-	lineNo int
+	reader  *bufio.Reader
+	file    string // Name of the file being read
+	lineNo  int
+	tokLine int       // Line the last token started on
+	eol     bool      // Last token was terminated by a newline
+	nested  []*nested // Included files and macro expansions being read, innermost last
+	locFile string    // Position set by .loc, if locLine is not zero
+	locLine int
+}
+
+// nested is text read in place of the rest of the source until it runs
+// out: an included file, or the body of an expanded macro
+type nested struct {
+	macro     string        // Name of the macro, or empty for an included file
+	defLine   int           // Line the macro was defined on
+	outer     *bufio.Reader // Reader to return to at the end
+	outerFile string        // File to return to at the end
+	outerLine int           // Line to return to at the end
+	siteLine  int           // Line of the include or expansion in the outer file
 }
 
 // NewParser creates a new parser from a reader
@@ -26,6 +45,130 @@ func (p *Parser) GetLineNo() int {
 	return p.lineNo
 }
 
+// File returns the name of the file being read. The body of a macro is
+// read from the file that defines it.
+func (p *Parser) File() string {
+	return p.file
+}
+
+// Position returns the file and line the last token started on. Tokens of
+// a macro expansion are at the outermost expansion site. After SetPosition,
+// every token is at the position set.
+func (p *Parser) Position() (string, int) {
+	if p.locLine != 0 {
+		return p.locFile, p.locLine
+	}
+	file, line := p.file, p.tokLine
+	for i := len(p.nested) - 1; i >= 0 && p.nested[i].macro != ""; i-- {
+		file, line = p.nested[i].outerFile, p.nested[i].siteLine
+	}
+	return file, line
+}
+
+// SetPosition makes the rest of the source count as being at line of file
+func (p *Parser) SetPosition(file string, line int) {
+	p.locFile, p.locLine = file, line
+}
+
+// TokenLine returns the line the last token started on, as for Position
+func (p *Parser) TokenLine() int {
+	_, line := p.Position()
+	return line
+}
+
+// Where describes the position of the last token for messages: its line,
+// with the file name if it is not the main source file
+func (p *Parser) Where() string {
+	return p.where(p.Position())
+}
+
+func (p *Parser) where(file string, line int) string {
+	main := p.file
+	if len(p.nested) > 0 {
+		main = p.nested[0].outerFile
+	}
+	if file == main {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// push starts reading text in place of the rest of the source
+func (p *Parser) push(n *nested, r io.Reader) {
+	n.outer, n.outerFile, n.outerLine, n.siteLine = p.reader, p.file, p.lineNo, p.tokLine
+	p.nested = append(p.nested, n)
+	p.reader = bufio.NewReader(r)
+}
+
+// Include reads a file before the rest of the source. The include
+// directive was the last token read.
+func (p *Parser) Include(file string, r io.Reader) {
+	p.push(&nested{}, r)
+	p.file, p.lineNo = file, 1
+}
+
+// Expand reads the text of a macro body before the rest of the source. The
+// body starts on the line after the definition in file, and the macro name
+// was the last token read.
+func (p *Parser) Expand(name, file string, defLine int, text string) {
+	p.push(&nested{macro: name, defLine: defLine}, strings.NewReader(text))
+	p.file, p.lineNo = file, defLine+1
+}
+
+// ExpansionDepth returns the number of nested macro expansions being read
+func (p *Parser) ExpansionDepth() int {
+	depth := 0
+	for _, n := range p.nested {
+		if n.macro != "" {
+			depth++
+		}
+	}
+	return depth
+}
+
+// Context describes where the last token came from if it is in an included
+// file or a macro expansion, or returns an empty string
+func (p *Parser) Context() string {
+	if len(p.nested) == 0 {
+		return ""
+	}
+
+	// Each step names a position and how it was reached from the next
+	type step struct {
+		where, verb string
+		times       int
+	}
+	var steps []step
+	file, line := p.file, p.tokLine
+	for i := len(p.nested) - 1; i >= 0; i-- {
+		n := p.nested[i]
+		st := step{where: p.where(file, line), verb: "included", times: 1}
+		if n.macro != "" {
+			st.where += fmt.Sprintf(" in macro %s defined at %s", n.macro, p.where(file, n.defLine))
+			st.verb = "expanded"
+		}
+
+		// Recursive expansions are listed once with a count
+		if k := len(steps); k > 0 && steps[k-1].where == st.where && steps[k-1].verb == st.verb {
+			steps[k-1].times++
+		} else {
+			steps = append(steps, st)
+		}
+		file, line = n.outerFile, n.siteLine
+	}
+
+	var b strings.Builder
+	for _, st := range steps {
+		b.WriteString(st.where)
+		if st.times > 1 {
+			fmt.Fprintf(&b, " %d times", st.times)
+		}
+		fmt.Fprintf(&b, ", %s at ", st.verb)
+	}
+	b.WriteString(p.where(file, line))
+	return b.String()
+}
+
 // UpdateLineNo updates the line number if a newline is encountered
 func (p *Parser) UpdateLineNo(c rune) rune {
 	if c == '\n' {
@@ -34,9 +177,17 @@ func (p *Parser) UpdateLineNo(c rune) rune {
 	return c
 }
 
-// GetChar reads a character, updating the line number if needed
+// GetChar reads a character, updating the line number if needed. At the
+// end of an included file or macro expansion, reading continues after the
+// include or expansion site.
 func (p *Parser) GetChar() (rune, error) {
 	r, _, err := p.reader.ReadRune()
+	for err == io.EOF && len(p.nested) > 0 {
+		n := p.nested[len(p.nested)-1]
+		p.nested = p.nested[:len(p.nested)-1]
+		p.reader, p.file, p.lineNo = n.outer, n.outerFile, n.outerLine
+		r, _, err = p.reader.ReadRune()
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -64,31 +215,187 @@ func (p *Parser) SkipWhitespace() error {
 	}
 }
 
-// NextToken returns the next token in the source
+// NextToken returns the next token in the source. A token that starts with
+// a double quote is a string: it runs to the closing quote on the same line
+// and may contain whitespace and backslash escapes.
 func (p *Parser) NextToken() (string, error) {
 	if err := p.SkipWhitespace(); err != nil && err != io.EOF {
 		return "", err
 	}
+	p.tokLine = p.lineNo
 
 	var buf bytes.Buffer
+	inString := false
 	for {
 		r, err := p.GetChar()
 		if err == io.EOF {
+			if inString {
+				return "", fmt.Errorf("line %d: unterminated string", p.tokLine)
+			}
 			// Return what we have so far
+			p.eol = true
 			return buf.String(), nil
 		}
 		if err != nil {
 			return "", err
 		}
+
+		// Strings run to the closing quote and may contain whitespace
+		if inString {
+			if r == '\n' {
+				return "", fmt.Errorf("line %d: unterminated string", p.tokLine)
+			}
+			buf.WriteRune(r)
+			if r == '"' {
+				inString = false
+			} else if r == '\\' {
+				// Keep the escaped character, even if it is a quote
+				r, err := p.GetChar()
+				if err != nil && err != io.EOF {
+					return "", err
+				}
+				if err == io.EOF || r == '\n' {
+					return "", fmt.Errorf("line %d: unterminated string", p.tokLine)
+				}
+				buf.WriteRune(r)
+			}
+			continue
+		}
+
 		if unicode.IsSpace(r) {
+			p.eol = r == '\n'
 			break
 		}
+		if r == '"' && buf.Len() == 0 {
+			inString = true
+		}
 		buf.WriteRune(r)
 	}
 
 	return buf.String(), nil
 }
 
+// PeekToken returns the next token on the line of the last token without
+// consuming it, or an empty string at the end of the line
+func (p *Parser) PeekToken() string {
+	if p.eol {
+		return ""
+	}
+
+	start := -1
+	for n := 1; ; n++ {
+		b, err := p.reader.Peek(n)
+		if len(b) < n {
+			if start < 0 || err == nil {
+				return ""
+			}
+			return string(b[start:])
+		}
+		ch := b[n-1]
+		if ch == '\n' {
+			if start < 0 {
+				return ""
+			}
+			return string(b[start : n-1])
+		}
+		if ch == ' ' || ch == '\t' || ch == '\r' {
+			if start >= 0 {
+				return string(b[start : n-1])
+			}
+			continue
+		}
+		if start < 0 {
+			start = n - 1
+		}
+	}
+}
+
+// LineTokens returns the remaining tokens on the line of the last token,
+// stopping at a comment
+func (p *Parser) LineTokens() ([]string, error) {
+	var tokens []string
+	if p.eol {
+		return tokens, nil
+	}
+
+	var buf bytes.Buffer
+	flush := func() {
+		if buf.Len() > 0 {
+			tokens = append(tokens, buf.String())
+			buf.Reset()
+		}
+	}
+
+	for {
+		r, err := p.GetChar()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r == '\n' {
+			break
+		}
+		if r == ';' && buf.Len() == 0 {
+			if err := p.SkipLine(); err != nil && err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		if unicode.IsSpace(r) {
+			flush()
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+
+	flush()
+	p.eol = true
+	return tokens, nil
+}
+
+// ReadLine returns the raw text of the next line, without the newline. It
+// returns io.EOF at the end of the source.
+func (p *Parser) ReadLine() (string, error) {
+	var buf bytes.Buffer
+	for {
+		r, err := p.GetChar()
+		if err == io.EOF && buf.Len() > 0 {
+			return buf.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if r == '\n' {
+			return buf.String(), nil
+		}
+		buf.WriteRune(r)
+	}
+}
+
+// splitTokens splits a line of source into tokens, stopping at a comment.
+// lineNo is the line number used in error messages.
+func splitTokens(line string, lineNo int) ([]string, error) {
+	p := NewParser(strings.NewReader(line))
+	p.lineNo = lineNo
+
+	var tokens []string
+	for !p.eol {
+		token, err := p.NextToken()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if strings.HasPrefix(token, ";") {
+			break
+		}
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
 // SkipLine skips to the end of the current line
 func (p *Parser) SkipLine() error {
 	for {
@@ -0,0 +1,191 @@
+package compiler
+
+import (
+	"io"
+	"math"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// Section names, in the order the sections are laid out
+var sectionOrder = []string{".text", ".data", ".bss"}
+
+// section is a part of the program assembled from address zero on its own
+// machine and moved to its place once the whole source has been compiled
+type section struct {
+	name  string
+	vm    *vm.VM
+	base  int32 // Address of the section in the program
+	align int32 // Largest alignment requested in the section
+}
+
+// reference is a word at Pos in sec to fill in at the end: the address of
+// the label Name for calls, the value of expr for expressions that depend
+// on addresses, or an address relative to the start of sec for relocations
+type reference struct {
+	vm.Label
+	sec      *section
+	expr     string
+	min, max int64  // Range of valid values of expr
+	msg      string // Error message for values out of range
+	context  string // Included file or macro expansion it was compiled in
+}
+
+// IsSectionDirective checks if a token switches to another section
+func (c *Compiler) IsSectionDirective(s string) bool {
+	for _, name := range sectionOrder {
+		if strings.ToLower(s) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SwitchSection continues compiling in the named section, creating it on
+// first use
+func (c *Compiler) SwitchSection(name string) {
+	name = strings.ToLower(name)
+	for _, sec := range c.sections {
+		if sec.name == name {
+			c.cur, c.vm = sec, sec.vm
+			return
+		}
+	}
+
+	sec := &section{name: name, vm: vm.NewMachine(c.errorFunc), align: 4}
+	c.sections = append(c.sections, sec)
+	c.cur, c.vm = sec, sec.vm
+}
+
+// inText reports whether code is being compiled into the text section,
+// whose addresses are final
+func (c *Compiler) inText() bool {
+	return c.cur == c.sections[0]
+}
+
+// relocate marks the word about to be emitted as an address relative to
+// the current section. Object files record text addresses as well, since
+// the linker moves the text too.
+func (c *Compiler) relocate(pos int32) {
+	if !c.inText() || c.object {
+		c.relocs = append(c.relocs, reference{Label: vm.NewLabel("", pos), sec: c.cur})
+	}
+}
+
+// IsSpaceDirective checks if a token reserves zero-filled space
+func (c *Compiler) IsSpaceDirective(s string) bool {
+	return strings.ToLower(s) == ".space"
+}
+
+// IsAlignDirective checks if a token aligns the next address
+func (c *Compiler) IsAlignDirective(s string) bool {
+	return strings.ToLower(s) == ".align"
+}
+
+// directiveSize reads the byte count following .space or .align, a
+// constant expression that must be a multiple of the word size
+func (c *Compiler) directiveSize(directive string, p *Parser) (int32, error) {
+	token, err := p.NextToken()
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+
+	val, err := c.Evaluate(token)
+	if err != nil {
+		c.Error(err.Error())
+		return 0, nil
+	}
+	if val < 0 || val > math.MaxInt32 || val%4 != 0 {
+		c.Error("Invalid size for " + directive + ", expected a multiple of 4: " + token)
+		return 0, nil
+	}
+	return int32(val), nil
+}
+
+// CompileSpace compiles a .space directive, reserving the given number of
+// bytes filled with zeros
+func (c *Compiler) CompileSpace(p *Parser) error {
+	size, err := c.directiveSize(".space", p)
+	if err != nil {
+		return err
+	}
+
+	for i := int32(0); i < size; i += 4 {
+		c.vm.LoadInt(0)
+	}
+	return nil
+}
+
+// CompileAlign compiles a .align directive, padding with zeros up to the
+// next multiple of the given power of two. Sections are placed so that the
+// alignment holds for the final addresses.
+func (c *Compiler) CompileAlign(p *Parser) error {
+	align, err := c.directiveSize(".align", p)
+	if err != nil {
+		return err
+	}
+	if align == 0 || align&(align-1) != 0 {
+		c.Error("Alignment must be a power of two of at least 4")
+		return nil
+	}
+
+	for c.vm.Pos()%align != 0 {
+		c.vm.LoadInt(0)
+	}
+	if align > c.cur.align {
+		c.cur.align = align
+	}
+	return nil
+}
+
+// layoutSections places the data and bss sections after the text section,
+// moves their words and labels into the program and applies relocations.
+// The program records the section boundaries if there is more than text.
+func (c *Compiler) layoutSections() {
+	text := c.sections[0]
+	end := text.vm.Pos()
+	regions := []vm.Region{{Kind: vm.SectionCode, Addr: 0, Size: end}}
+
+	for _, name := range sectionOrder[1:] {
+		var sec *section
+		for _, s := range c.sections {
+			if s.name == name {
+				sec = s
+			}
+		}
+		if sec == nil {
+			continue
+		}
+
+		kind := sec.kind()
+		end = vm.AlignUp(end, sec.align)
+		sec.base = end
+		size := sec.vm.Pos()
+		for off := int32(0); off < size; off += 4 {
+			word := sec.vm.GetMem(off)
+			if kind == vm.SectionBSS && word != 0 {
+				c.Error("Only .space, .align and labels may be used in .bss")
+				break
+			}
+			c.machine.SetMem(end+off, word)
+		}
+		for _, label := range sec.vm.Labels() {
+			c.machine.AddLabel(label.Name, end+label.Pos)
+		}
+
+		if size > 0 {
+			regions = append(regions, vm.Region{Kind: kind, Addr: end, Size: size})
+		}
+		end += size
+	}
+
+	for _, r := range c.relocs {
+		addr := r.sec.base + r.Pos
+		c.machine.SetMem(addr, c.machine.GetMem(addr)+r.sec.base)
+	}
+
+	if len(regions) > 1 {
+		c.machine.SetRegions(regions)
+	}
+}
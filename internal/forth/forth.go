@@ -0,0 +1,594 @@
+// Package forth translates a subset of Forth to stack machine assembler
+// source, which the compiler turns into an image. Each line of Forth
+// becomes one line of assembler, so compiler messages and the line table
+// refer to the Forth source.
+package forth
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+)
+
+// primitive is a Forth word translated to a fixed sequence of assembler
+// tokens
+type primitive struct {
+	code   string
+	module string // Standard library module the code calls, if any
+}
+
+// Forth flags are -1 for true, so the 1 of library comparisons is negated
+var primitives = map[string]primitive{
+	// Stack
+	"dup":   {"dup", ""},
+	"drop":  {"drop", ""},
+	"swap":  {"swap", ""},
+	"over":  {"swap dup rol3 swap", ""},
+	"rot":   {"rol3", ""},
+	"-rot":  {"rol3 rol3", ""},
+	"nip":   {"swap drop", ""},
+	"tuck":  {"dup rol3 swap", ""},
+	"2dup":  {"swap dup rol3 swap swap dup rol3 swap", ""},
+	"2drop": {"drop drop", ""},
+	"?dup":  {"dup if dup then", ""},
+
+	// Arithmetic
+	"+":      {"add", ""},
+	"-":      {"swap sub", ""},
+	"*":      {"mul", "arith"},
+	"/":      {"div", "arith"},
+	"mod":    {"mod", "arith"},
+	"/mod":   {"divmod swap", "arith"},
+	"1+":     {"inc", "arith"},
+	"1-":     {"dec", "arith"},
+	"2*":     {"dup add", ""},
+	"negate": {"negate", "arith"},
+	"abs":    {"abs", "arith"},
+	"min":    {"min", "compare"},
+	"max":    {"max", "compare"},
+	"and":    {"and", ""},
+	"or":     {"or", ""},
+	"xor":    {"xor", ""},
+	"invert": {"compl", ""},
+
+	// Comparisons
+	"=":     {"eq negate", "compare"},
+	"<>":    {"ne negate", "compare"},
+	"<":     {"lt negate", "compare"},
+	">":     {"gt negate", "compare"},
+	"u<":    {"ult negate", "compare"},
+	"0=":    {"zero? negate", "compare"},
+	"0<>":   {"zero? not negate", "compare"},
+	"0<":    {"neg? negate", "compare"},
+	"0>":    {"0 gt negate", "compare"},
+	"true":  {"-1", ""},
+	"false": {"0", ""},
+
+	// Memory
+	"@":     {"load", ""},
+	"!":     {"stor", ""},
+	"+!":    {"dup load rol3 add swap stor", ""},
+	"cells": {"dup add dup add", ""},
+	"cell+": {"4 add", ""},
+
+	// Input and output
+	".":      {"outnum 32 out", ""},
+	"emit":   {"out", ""},
+	"key":    {"in", ""},
+	"cr":     {"10 out", ""},
+	"space":  {"32 out", ""},
+	"spaces": {"begin dup 0 gt while 32 out dec repeat drop", "arith compare"},
+	"bye":    {"halt", ""},
+
+	// The return stack of >R and R> is kept in memory, since the IP stack
+	// holding return addresses cannot be read as data
+	">r": {"forth.rpush", ""},
+	"r>": {"forth.rpop", ""},
+	"r@": {"forth.rpeek", ""},
+}
+
+// controlWords are compiled by the compiler's structured control flow
+var controlWords = map[string]bool{
+	"if": true, "else": true, "then": true, "begin": true, "until": true,
+	"while": true, "repeat": true, "do": true, "loop": true,
+}
+
+// RStackCells is the number of cells of the return stack used by >R
+const RStackCells = 256
+
+// MaxLine is the longest source line read, in bytes
+const MaxLine = 64 << 20
+
+// Codes thrown when the return stack overflows or is empty: the ANS Forth
+// codes -5 and -6 less 100, out of the range of the VM's fault codes
+const (
+	RStackOverflow  = -105
+	RStackUnderflow = -106
+)
+
+// rstackWords returns the functions that >R, R> and R@ call. forth.rp is
+// the offset of the top cell in forth.rstack, whose first cell is unused.
+func rstackWords() []string {
+	full := strconv.Itoa(4 * (RStackCells + 1))
+	return strings.Fields(`&forth.rstack.end jmp
+forth.rpush:
+  &forth.rp load 4 add
+  dup ` + full + ` xor &forth.rpush.ok swap jnz
+  ` + strconv.Itoa(RStackOverflow) + ` throw
+forth.rpush.ok:
+  dup &forth.rp stor &forth.rstack add stor
+  popip
+forth.rpop:
+  &forth.rp load
+  dup &forth.rpop.ok swap jnz
+  ` + strconv.Itoa(RStackUnderflow) + ` throw
+forth.rpop.ok:
+  dup &forth.rstack add load swap -4 add &forth.rp stor
+  popip
+forth.rpeek:
+  &forth.rp load
+  dup &forth.rpeek.ok swap jnz
+  ` + strconv.Itoa(RStackUnderflow) + ` throw
+forth.rpeek.ok:
+  &forth.rstack add load
+  popip
+forth.rstack.end:`)
+}
+
+// word is a word defined by the program
+type word struct {
+	label string
+	value int32
+	kind  int
+}
+
+const (
+	colonWord = iota // Called
+	dataWord         // Pushes its address
+	constWord        // Pushes its value
+)
+
+// literal is a number or constant that may still be taken by CONSTANT,
+// ALLOT, "," or CELLS at the top level
+type literal struct {
+	value int64
+	line  int
+}
+
+type translator struct {
+	out     [][]string // Assembler tokens of each line
+	line    int        // Current line, from 1
+	text    string     // Rest of the current line
+	comment bool       // Inside a ( comment continued from an earlier line
+
+	words   map[string]*word
+	labels  map[string]int // Definitions of each name, for redefinitions
+	def     *word          // Colon definition being compiled
+	defName string
+	loops   int // Open DO loops of the definition or the top level
+	skips   int // Labels after colon definitions
+	strs    int // Strings printed by ."
+	pending *literal
+	modules map[string]bool
+	rstack  bool
+}
+
+// Translate translates Forth source to assembler source
+func Translate(r io.Reader) (string, error) {
+	t := &translator{
+		words:   make(map[string]*word),
+		labels:  make(map[string]int),
+		modules: make(map[string]bool),
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MaxLine)
+	for scanner.Scan() {
+		t.line++
+		t.out = append(t.out, nil)
+		t.text = scanner.Text()
+		if err := t.translateLine(); err != nil {
+			return "", err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if err := t.finish(); err != nil {
+		return "", err
+	}
+	return t.source(), nil
+}
+
+// source joins the translated lines, importing the library modules used
+// on the first line and placing the return stack and its words on the last
+func (t *translator) source() string {
+	if len(t.out) == 0 {
+		t.out = append(t.out, nil)
+	}
+
+	var imports []string
+	for module := range t.modules {
+		imports = append(imports, "import "+module)
+	}
+	sort.Strings(imports)
+	t.out[0] = append(imports, t.out[0]...)
+
+	if t.rstack {
+		last := len(t.out) - 1
+		t.out[last] = append(t.out[last], rstackWords()...)
+		t.out[last] = append(t.out[last], ".bss", "forth.rp:", ".space", "4",
+			"forth.rstack:", ".space", strconv.Itoa(4*(RStackCells+1)), ".text")
+	}
+
+	var sb strings.Builder
+	for _, tokens := range t.out {
+		sb.WriteString(strings.Join(tokens, " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// finish checks that nothing is left open at the end of the source
+func (t *translator) finish() error {
+	if t.comment {
+		return t.errorf("Comment at end of source is not closed")
+	}
+	if t.def != nil {
+		return t.errorf("Definition of %s is not closed", t.defName)
+	}
+	t.flush()
+	return nil
+}
+
+func (t *translator) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(format+" at line %d", append(args, t.line)...)
+}
+
+// emit appends assembler code to the current line
+func (t *translator) emit(code ...string) {
+	t.flush()
+	t.out[t.line-1] = append(t.out[t.line-1], code...)
+}
+
+// flush pushes a pending number where it was written
+func (t *translator) flush() {
+	if t.pending != nil {
+		n := t.pending.line - 1
+		t.out[n] = append(t.out[n], strconv.FormatInt(t.pending.value, 10))
+		t.pending = nil
+	}
+}
+
+// take returns the pending number used by a defining word
+func (t *translator) take(name string) (int64, error) {
+	if t.pending == nil {
+		return 0, t.errorf("%s needs a number before it", strings.ToUpper(name))
+	}
+	val := t.pending.value
+	t.pending = nil
+	return val, nil
+}
+
+// next returns the next word of the current line, or "" at its end
+func (t *translator) next() string {
+	t.text = strings.TrimLeft(t.text, " \t\r")
+	n := strings.IndexAny(t.text, " \t\r")
+	if n < 0 {
+		n = len(t.text)
+	}
+	s := t.text[:n]
+	t.text = t.text[n:]
+	return s
+}
+
+// upTo returns the text of the current line up to delim, removing it and
+// the delimiter. The single space after the word starting the text is
+// skipped.
+func (t *translator) upTo(delim byte) (string, bool) {
+	t.text = strings.TrimPrefix(t.text, " ")
+	n := strings.IndexByte(t.text, delim)
+	if n < 0 {
+		s := t.text
+		t.text = ""
+		return s, false
+	}
+	s := t.text[:n]
+	t.text = t.text[n+1:]
+	return s, true
+}
+
+// name returns the name following a defining word
+func (t *translator) name(definer string) (string, error) {
+	s := strings.ToLower(t.next())
+	if s == "" {
+		return "", t.errorf("%s needs a name", strings.ToUpper(definer))
+	}
+	return s, nil
+}
+
+// label returns a new label for a defined word. Names are case
+// insensitive and characters other than letters and digits are escaped.
+// A word defined again gets a new label with a numbered suffix, since
+// code compiled before keeps using the old definition.
+func (t *translator) label(name string) string {
+	var sb strings.Builder
+	sb.WriteString("fw.")
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		if ch >= 'a' && ch <= 'z' || ch >= '0' && ch <= '9' {
+			sb.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&sb, "_%02x", ch)
+		}
+	}
+
+	label := sb.String()
+	t.labels[label]++
+	if n := t.labels[label]; n > 1 {
+		label += "." + strconv.Itoa(n)
+	}
+	return label
+}
+
+func (t *translator) translateLine() error {
+	if t.comment {
+		if _, ok := t.upTo(')'); !ok {
+			return nil
+		}
+		t.comment = false
+	}
+
+	for {
+		s := t.next()
+		if s == "" {
+			return nil
+		}
+		if err := t.translateWord(s); err != nil {
+			return err
+		}
+		if t.comment {
+			return nil
+		}
+	}
+}
+
+func (t *translator) translateWord(s string) error {
+	name := strings.ToLower(s)
+	switch name {
+	case "\\":
+		t.text = ""
+		return nil
+	case "(":
+		_, ok := t.upTo(')')
+		t.comment = !ok
+		return nil
+	case ":":
+		return t.colon()
+	case ";":
+		return t.semicolon()
+	case "variable", "create":
+		return t.create(name)
+	case "constant":
+		return t.constant()
+	case "allot", ",":
+		return t.allot(name)
+	case "cells":
+		if t.pending != nil {
+			t.pending.value *= 4
+			return nil
+		}
+	case "char", "[char]":
+		ch := t.next()
+		if ch == "" {
+			return t.errorf("%s needs a character", strings.ToUpper(name))
+		}
+		t.emit(strconv.Itoa(int(ch[0])))
+		return nil
+	case ".\"":
+		return t.printString()
+	case "recurse":
+		if t.def == nil {
+			return t.errorf("RECURSE outside a definition")
+		}
+		t.emit(t.def.label)
+		return nil
+	case "exit":
+		if t.def == nil {
+			return t.errorf("EXIT outside a definition")
+		}
+		// DO loops of a definition keep their slots in one frame
+		if t.loops > 0 {
+			t.emit("leave")
+		}
+		t.emit("popip")
+		return nil
+	}
+
+	if w, ok := t.words[name]; ok {
+		switch w.kind {
+		case colonWord:
+			t.emit(w.label)
+		case dataWord:
+			t.emit("&" + w.label)
+		case constWord:
+			t.number(int64(w.value))
+		}
+		return nil
+	}
+
+	if controlWords[name] {
+		return t.control(name)
+	}
+
+	switch name {
+	case "i", "j", "again":
+		return t.control(name)
+	}
+
+	if p, ok := primitives[name]; ok {
+		for _, module := range strings.Fields(p.module) {
+			t.modules[module] = true
+		}
+		t.rstack = t.rstack || strings.Contains(p.code, "forth.r")
+		t.emit(p.code)
+		return nil
+	}
+
+	if val, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if val < -1<<31 || val > 1<<31-1 {
+			return t.errorf("Number out of range: %s", s)
+		}
+		t.number(val)
+		return nil
+	}
+
+	return t.errorf("Unknown word %s", s)
+}
+
+// number pushes a number, which outside definitions is left pending for
+// a defining word that may follow
+func (t *translator) number(val int64) {
+	if t.def != nil {
+		t.emit(strconv.FormatInt(val, 10))
+		return
+	}
+	t.flush()
+	t.pending = &literal{value: val, line: t.line}
+}
+
+// control translates a structured control flow word, counting DO loops
+// for I, J and EXIT
+func (t *translator) control(name string) error {
+	switch name {
+	case "do":
+		t.loops++
+	case "loop":
+		t.loops--
+	case "i":
+		if t.loops < 1 {
+			return t.errorf("I outside a DO loop")
+		}
+	case "j":
+		if t.loops < 2 {
+			return t.errorf("J outside two DO loops")
+		}
+	case "again":
+		t.emit("0 until")
+		return nil
+	}
+	t.emit(name)
+	return nil
+}
+
+// colon starts a colon definition, which the code around it jumps over
+func (t *translator) colon() error {
+	if t.def != nil {
+		return t.errorf("Definition inside the definition of %s", t.defName)
+	}
+	if t.loops > 0 {
+		return t.errorf("Definition inside a DO loop")
+	}
+	name, err := t.name(":")
+	if err != nil {
+		return err
+	}
+
+	t.def = &word{label: t.label(name), kind: colonWord}
+	t.defName = name
+	t.skips++
+	t.emit(fmt.Sprintf("&forth.skip.%d", t.skips), "jmp", t.def.label+":")
+	return nil
+}
+
+// semicolon ends a colon definition, after which its name refers to it
+func (t *translator) semicolon() error {
+	if t.def == nil {
+		return t.errorf("; without :")
+	}
+	t.emit("popip", fmt.Sprintf("forth.skip.%d:", t.skips))
+	t.words[t.defName] = t.def
+	t.def = nil
+	return nil
+}
+
+// create defines a word pushing the address of data space, in the data
+// section. VARIABLE reserves a cell for it.
+func (t *translator) create(definer string) error {
+	if t.def != nil {
+		return t.errorf("%s inside a definition", strings.ToUpper(definer))
+	}
+	name, err := t.name(definer)
+	if err != nil {
+		return err
+	}
+
+	w := &word{label: t.label(name), kind: dataWord}
+	if definer == "variable" {
+		t.emit(".data", w.label+":", "embed", "0", ".text")
+	} else {
+		t.emit(".data", w.label+":", ".text")
+	}
+	t.words[name] = w
+	return nil
+}
+
+// constant defines a word pushing the number before it
+func (t *translator) constant() error {
+	if t.def != nil {
+		return t.errorf("CONSTANT inside a definition")
+	}
+	val, err := t.take("constant")
+	if err != nil {
+		return err
+	}
+	name, err := t.name("constant")
+	if err != nil {
+		return err
+	}
+
+	t.words[name] = &word{value: int32(val), kind: constWord}
+	return nil
+}
+
+// allot reserves the number of bytes before it in data space; ","
+// stores the number before it in the next cell
+func (t *translator) allot(definer string) error {
+	if t.def != nil {
+		return t.errorf("%s inside a definition", strings.ToUpper(definer))
+	}
+	val, err := t.take(definer)
+	if err != nil {
+		return err
+	}
+
+	if definer == "allot" {
+		if val < 0 {
+			return t.errorf("ALLOT of a negative size")
+		}
+		t.emit(".data", ".space", strconv.FormatInt(val, 10), ".text")
+	} else {
+		t.emit(".data", "embed", strconv.FormatInt(val, 10), ".text")
+	}
+	return nil
+}
+
+// printString translates ." text", which prints the text as a string in
+// the data section
+func (t *translator) printString() error {
+	text, ok := t.upTo('"')
+	if !ok {
+		return t.errorf("String is not closed")
+	}
+
+	t.strs++
+	label := fmt.Sprintf("forth.str.%d", t.strs)
+	t.emit(".data", label+":", "embed", compiler.Quote(text), ".text", "&"+label, "print")
+	t.modules["string"] = true
+	return nil
+}
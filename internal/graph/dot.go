@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// quote returns s as a DOT string
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// nodeID returns the DOT node name of the block or function at addr
+func nodeID(addr int32) string {
+	return fmt.Sprintf("b%x", addr)
+}
+
+// operand returns an instruction's immediate, as &label where one names it
+func (g *Graph) operand(in vm.Instruction) string {
+	if !in.Op.HasOperand() {
+		return ""
+	}
+	if names := g.labels[in.Operand]; len(names) > 0 && in.Op != vm.LOADL && in.Op != vm.STORL && in.Op != vm.ENTER {
+		return " &" + names[0]
+	}
+	return fmt.Sprintf(" %d", in.Operand)
+}
+
+// blockLabel returns the text shown for a block: its labels followed by
+// its instructions, left aligned
+func (g *Graph) blockLabel(b *Block) string {
+	var sb strings.Builder
+	for _, name := range g.labels[b.Start] {
+		sb.WriteString(name + ":\\l")
+	}
+	for _, in := range b.Instrs {
+		text := fmt.Sprintf("0x%x %s%s", in.Addr, in.Op, g.operand(in))
+		text = strings.ReplaceAll(text, `\`, `\\`)
+		sb.WriteString(strings.ReplaceAll(text, `"`, `\"`) + "\\l")
+	}
+	if !b.Reachable {
+		sb.WriteString("(unreachable)\\l")
+	}
+	return `"` + sb.String() + `"`
+}
+
+// WriteCFG writes the control-flow graph in Graphviz DOT format. Blocks not
+// reachable from the entry point are drawn dashed and gray.
+func (g *Graph) WriteCFG(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph cfg {")
+	fmt.Fprintln(bw, "\tnode [shape=box, fontname=monospace];")
+
+	indirect := false
+	for _, b := range g.Blocks {
+		attrs := "label=" + g.blockLabel(b)
+		if !b.Reachable {
+			attrs += ", style=dashed, color=gray, fontcolor=gray"
+		}
+		if b.Start == g.Entry {
+			attrs += ", peripheries=2"
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", nodeID(b.Start), attrs)
+
+		for _, e := range b.Succs {
+			fmt.Fprintf(bw, "\t%s -> %s%s;\n", nodeID(b.Start), nodeID(e.To), edgeAttrs(e.Kind))
+		}
+		if b.Indirect {
+			fmt.Fprintf(bw, "\t%s -> indirect [style=dashed];\n", nodeID(b.Start))
+			indirect = true
+		}
+	}
+
+	if indirect {
+		fmt.Fprintln(bw, "\tindirect [label=\"?\", shape=circle];")
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// edgeAttrs returns the DOT attributes of an edge of the given kind
+func edgeAttrs(kind EdgeKind) string {
+	switch kind {
+	case Next:
+		return ""
+	case Call:
+		return ` [label="call", style=dashed]`
+	case Handler:
+		return ` [label="catch", style=dotted]`
+	}
+	return " [label=" + quote(kind.String()) + "]"
+}
+
+// WriteCallGraph writes the function call graph in Graphviz DOT format.
+// Functions not reachable from the entry point are drawn dashed and gray.
+func (g *Graph) WriteCallGraph(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph calls {")
+	fmt.Fprintln(bw, "\tnode [shape=box, fontname=monospace];")
+
+	indirect := false
+	for _, f := range g.Functions {
+		attrs := "label=" + quote(g.Name(f.Entry))
+		if b := g.byAddr[f.Entry]; b != nil && !b.Reachable {
+			attrs += ", style=dashed, color=gray, fontcolor=gray"
+		}
+		if f.Entry == g.Entry {
+			attrs += ", peripheries=2"
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", nodeID(f.Entry), attrs)
+
+		for _, callee := range f.Callees {
+			fmt.Fprintf(bw, "\t%s -> %s;\n", nodeID(f.Entry), nodeID(callee))
+		}
+		if f.Indirect {
+			fmt.Fprintf(bw, "\t%s -> indirect [style=dashed];\n", nodeID(f.Entry))
+			indirect = true
+		}
+	}
+
+	if indirect {
+		fmt.Fprintln(bw, "\tindirect [label=\"?\", shape=circle];")
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
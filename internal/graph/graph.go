@@ -0,0 +1,413 @@
+// Package graph builds control-flow and call graphs from program images
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// EdgeKind describes how control passes from one block to another
+type EdgeKind int
+
+// Edge kinds
+const (
+	Next     EdgeKind = iota // Fall through to the following block
+	Jump                     // Unconditional jump
+	Taken                    // Conditional branch taken
+	NotTaken                 // Conditional branch not taken
+	Call                     // Call of a function
+	Return                   // Continuation after a call returns
+	Handler                  // Exception handler installed by TRY
+)
+
+// String returns the edge label used in DOT output
+func (k EdgeKind) String() string {
+	switch k {
+	case Jump:
+		return "jump"
+	case Taken:
+		return "taken"
+	case NotTaken:
+		return "not taken"
+	case Call:
+		return "call"
+	case Return:
+		return "return"
+	case Handler:
+		return "catch"
+	}
+	return ""
+}
+
+// Edge is a control transfer to the block starting at To
+type Edge struct {
+	To   int32
+	Kind EdgeKind
+}
+
+// Block is a basic block: a run of instructions entered only at the first
+// and left only after the last
+type Block struct {
+	Start     int32
+	Instrs    []vm.Instruction
+	Succs     []Edge
+	Indirect  bool // Ends with a jump or call to a computed address
+	Reachable bool // Reachable from the entry point
+}
+
+// Function is a block that is called, or the entry point, together with
+// the blocks reachable from it without following calls
+type Function struct {
+	Entry    int32
+	Blocks   []int32
+	Callees  []int32 // Entries of called functions, sorted
+	Indirect bool    // Calls or jumps to computed addresses
+}
+
+// Graph holds the basic blocks and functions of a program
+type Graph struct {
+	Entry     int32
+	Blocks    []*Block // Sorted by address
+	Functions []*Function
+	labels    map[int32][]string
+	byAddr    map[int32]*Block
+}
+
+// Name returns the first label at addr, or the address in hex
+func (g *Graph) Name(addr int32) string {
+	if names := g.labels[addr]; len(names) > 0 {
+		return names[0]
+	}
+	return fmt.Sprintf("0x%x", addr)
+}
+
+// Block returns the block starting at addr
+func (g *Graph) Block(addr int32) *Block {
+	return g.byAddr[addr]
+}
+
+// transfer is the resolved control transfer of an instruction
+type transfer struct {
+	target   int32
+	known    bool
+	isCall   bool   // JMP that completes a PUSHIP return / PUSH function call
+	retKnown bool   // POPIP or RET of a return address pushed in the same block
+	cond     *int32 // Constant condition of JZ or JNZ
+}
+
+// constTracker follows constants pushed within a block
+type constTracker struct {
+	data []*int32
+	ip   []*int32
+}
+
+func (t *constTracker) reset() {
+	t.data = t.data[:0]
+	t.ip = t.ip[:0]
+}
+
+func pop(stack *[]*int32) *int32 {
+	if len(*stack) == 0 {
+		return nil
+	}
+	v := (*stack)[len(*stack)-1]
+	*stack = (*stack)[:len(*stack)-1]
+	return v
+}
+
+// step updates the tracked stacks for one instruction and returns its
+// control transfer
+func (t *constTracker) step(in vm.Instruction) transfer {
+	var tr transfer
+
+	switch in.Op {
+	case vm.PUSH:
+		n := in.Operand
+		t.data = append(t.data, &n)
+		return tr
+	case vm.PUSHIP:
+		n := in.Operand
+		t.ip = append(t.ip, &n)
+		return tr
+	case vm.DROPIP:
+		pop(&t.ip)
+		return tr
+	case vm.DUP:
+		a := pop(&t.data)
+		t.data = append(t.data, a, a)
+		return tr
+	case vm.SWAP:
+		b, a := pop(&t.data), pop(&t.data)
+		t.data = append(t.data, b, a)
+		return tr
+	case vm.ROL3:
+		c, b, a := pop(&t.data), pop(&t.data), pop(&t.data)
+		t.data = append(t.data, b, c, a)
+		return tr
+	case vm.JMP, vm.CALLS:
+		if target := pop(&t.data); target != nil {
+			tr.target, tr.known = *target, true
+		}
+		if in.Op == vm.JMP && tr.known {
+			if ret := pop(&t.ip); ret != nil && *ret == in.Next() {
+				tr.isCall = true
+			}
+		}
+		return tr
+	case vm.JZ, vm.JNZ:
+		tr.cond = pop(&t.data)
+		if target := pop(&t.data); target != nil {
+			tr.target, tr.known = *target, true
+		}
+		return tr
+	case vm.CALL:
+		tr.target, tr.known = in.Operand, true
+		return tr
+	case vm.POPIP, vm.RET:
+		if ret := pop(&t.ip); ret != nil {
+			tr.target, tr.known, tr.retKnown = *ret, true, true
+		}
+		return tr
+	}
+
+	pops, pushes := in.Op.StackEffect()
+	for i := 0; i < pops; i++ {
+		pop(&t.data)
+	}
+	for i := 0; i < pushes; i++ {
+		t.data = append(t.data, nil)
+	}
+	return tr
+}
+
+// endsBlock reports whether an instruction ends its basic block
+func endsBlock(op vm.Op) bool {
+	switch op {
+	case vm.JMP, vm.JZ, vm.JNZ, vm.CALL, vm.CALLS, vm.POPIP, vm.RET, vm.THROW, vm.EXIT:
+		return true
+	}
+	return op < vm.NOP || op >= vm.NOP_END
+}
+
+// Build decodes image from address zero and splits it into basic blocks.
+// Jump and call targets are resolved where they are constants pushed in the
+// same block, which covers compiled label references, CALL and the
+// PUSHIP / PUSH / JMP call sequence. Blocks not reachable from entry are
+// marked as such.
+func Build(image []int32, entry int32, labels []vm.Label) *Graph {
+	g := &Graph{
+		Entry:  entry,
+		labels: make(map[int32][]string),
+		byAddr: make(map[int32]*Block),
+	}
+	for _, l := range labels {
+		g.labels[l.Pos] = append(g.labels[l.Pos], l.Name)
+	}
+
+	// Decode linearly; words that are not instructions end a block
+	var instrs []vm.Instruction
+	for addr := int32(0); vm.IsCodeAddress(image, addr); {
+		in, ok := vm.Decode(image, addr)
+		if !ok {
+			in.Op = vm.NOP_END
+		}
+		instrs = append(instrs, in)
+		if !ok {
+			break
+		}
+		addr = in.Next()
+	}
+
+	// Find block leaders, adding resolved targets until nothing changes
+	leaders := map[int32]bool{entry: true}
+	for addr := range g.labels {
+		leaders[addr] = true
+	}
+	var transfers []transfer
+	for {
+		transfers = resolve(instrs, leaders)
+		changed := false
+		for i, in := range instrs {
+			tr := transfers[i]
+			if endsBlock(in.Op) && i+1 < len(instrs) && !leaders[in.Next()] {
+				leaders[in.Next()] = true
+				changed = true
+			}
+			if in.Op == vm.TRY && !leaders[in.Operand] {
+				leaders[in.Operand] = true
+				changed = true
+			}
+			// A jump to itself halts and does not start a block
+			if tr.known && tr.target != in.Addr && !leaders[tr.target] {
+				leaders[tr.target] = true
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Split into blocks and connect them
+	var cur *Block
+	for i, in := range instrs {
+		if cur == nil || leaders[in.Addr] {
+			if cur != nil && !endsBlock(cur.Instrs[len(cur.Instrs)-1].Op) {
+				cur.Succs = append(cur.Succs, Edge{To: in.Addr, Kind: Next})
+			}
+			cur = &Block{Start: in.Addr}
+			g.Blocks = append(g.Blocks, cur)
+			g.byAddr[in.Addr] = cur
+		}
+		cur.Instrs = append(cur.Instrs, in)
+		g.connect(cur, in, transfers[i], image)
+	}
+
+	g.markReachable()
+	g.findFunctions()
+	return g
+}
+
+// resolve tracks constants through each block as delimited by leaders
+func resolve(instrs []vm.Instruction, leaders map[int32]bool) []transfer {
+	transfers := make([]transfer, len(instrs))
+	var t constTracker
+	for i, in := range instrs {
+		if leaders[in.Addr] {
+			t.reset()
+		}
+		transfers[i] = t.step(in)
+		if endsBlock(in.Op) {
+			t.reset()
+		}
+	}
+	return transfers
+}
+
+// connect adds the edges leaving a block through instruction in
+func (g *Graph) connect(b *Block, in vm.Instruction, tr transfer, image []int32) {
+	edge := func(to int32, kind EdgeKind) {
+		if vm.IsCodeAddress(image, to) {
+			b.Succs = append(b.Succs, Edge{To: to, Kind: kind})
+		}
+	}
+	target := func(kind EdgeKind) {
+		if tr.known {
+			edge(tr.target, kind)
+		} else {
+			b.Indirect = true
+		}
+	}
+
+	switch in.Op {
+	case vm.TRY:
+		edge(in.Operand, Handler)
+	case vm.JMP:
+		switch {
+		case tr.known && tr.target == in.Addr:
+			// Halt
+		case tr.isCall:
+			edge(tr.target, Call)
+			edge(in.Next(), Return)
+		default:
+			target(Jump)
+		}
+	case vm.JZ, vm.JNZ:
+		// Only one way is followed if the condition is constant
+		taken := tr.cond == nil || (*tr.cond == 0) == (in.Op == vm.JZ)
+		if taken {
+			target(Taken)
+		}
+		if tr.cond == nil || !taken {
+			edge(in.Next(), NotTaken)
+		}
+	case vm.CALL, vm.CALLS:
+		target(Call)
+		edge(in.Next(), Return)
+	case vm.POPIP, vm.RET:
+		if tr.retKnown {
+			edge(tr.target, Jump)
+		}
+	}
+}
+
+// markReachable marks the blocks reachable from the entry point
+func (g *Graph) markReachable() {
+	work := []int32{g.Entry}
+	for len(work) > 0 {
+		b := g.byAddr[work[len(work)-1]]
+		work = work[:len(work)-1]
+		if b == nil || b.Reachable {
+			continue
+		}
+		b.Reachable = true
+		for _, e := range b.Succs {
+			work = append(work, e.To)
+		}
+	}
+}
+
+// findFunctions collects functions: the entry point, call targets, and
+// labeled blocks that no other block falls or jumps into, such as the
+// functions of a library
+func (g *Graph) findFunctions() {
+	entries := map[int32]bool{g.Entry: true}
+	incoming := make(map[int32]bool)
+	for _, b := range g.Blocks {
+		for _, e := range b.Succs {
+			if e.Kind == Call {
+				entries[e.To] = true
+			} else {
+				incoming[e.To] = true
+			}
+		}
+	}
+	for addr := range g.labels {
+		if g.byAddr[addr] != nil && !incoming[addr] {
+			entries[addr] = true
+		}
+	}
+
+	for entry := range entries {
+		if g.byAddr[entry] == nil {
+			continue
+		}
+
+		f := &Function{Entry: entry}
+		seen := make(map[int32]bool)
+		callees := make(map[int32]bool)
+		work := []int32{entry}
+		for len(work) > 0 {
+			addr := work[len(work)-1]
+			work = work[:len(work)-1]
+			b := g.byAddr[addr]
+			if b == nil || seen[addr] {
+				continue
+			}
+			seen[addr] = true
+			f.Blocks = append(f.Blocks, addr)
+			f.Indirect = f.Indirect || b.Indirect
+
+			for _, e := range b.Succs {
+				if e.Kind == Call {
+					callees[e.To] = true
+				} else {
+					work = append(work, e.To)
+				}
+			}
+		}
+
+		sort.Slice(f.Blocks, func(i, j int) bool { return f.Blocks[i] < f.Blocks[j] })
+		for addr := range callees {
+			f.Callees = append(f.Callees, addr)
+		}
+		sort.Slice(f.Callees, func(i, j int) bool { return f.Callees[i] < f.Callees[j] })
+		g.Functions = append(g.Functions, f)
+	}
+
+	sort.Slice(g.Functions, func(i, j int) bool {
+		return g.Functions[i].Entry < g.Functions[j].Entry
+	})
+}
@@ -0,0 +1,177 @@
+package linker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/objfile"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// sectionOrder is the order sections are placed in: the code of every
+// object, then their data, then their bss
+var sectionOrder = []vm.SectionKind{vm.SectionCode, vm.SectionData, vm.SectionBSS}
+
+// IsLocal reports whether a label is private to its object. Labels of
+// macro expansions, which contain @, are unique only within one object.
+func IsLocal(name string) bool {
+	return strings.Contains(name, "@")
+}
+
+// Link lays out objects and resolves their labels and relocations into a
+// program. The code sections come first, in the order of the objects; the
+// data and bss sections follow. Execution starts at the code of the first
+// object, which is followed by a halt as the end of a compiled program is.
+// Duplicate and undefined labels are reported together.
+func Link(objs []*objfile.Object) (*vm.VM, error) {
+	var memErr string
+	m := vm.NewMachine(func(msg string) {
+		if memErr == "" {
+			memErr = msg
+		}
+	})
+
+	// Lay out the sections, recording where each one starts
+	bases := make([]map[vm.SectionKind]int32, len(objs))
+	for i := range bases {
+		bases[i] = make(map[vm.SectionKind]int32)
+	}
+
+	var regions []vm.Region
+	addr := int32(0)
+	for _, kind := range sectionOrder {
+		start := int32(-1)
+		for i, obj := range objs {
+			for _, s := range obj.Sections {
+				if s.Kind != kind {
+					continue
+				}
+				addr = vm.AlignUp(addr, s.Align)
+				if start < 0 {
+					start = addr
+				}
+				bases[i][kind] = addr
+				for j, word := range s.Words {
+					m.SetMem(addr+int32(j)*4, word)
+				}
+				addr += s.Len()
+			}
+
+			if kind == vm.SectionCode && i == 0 {
+				// The entry object ends with a halt, so that it does not run
+				// on into the code of the next object
+				m.SetMem(addr, int32(vm.PUSH))
+				m.SetMem(addr+4, addr+8)
+				m.SetMem(addr+8, int32(vm.JMP))
+				addr += 12
+				start = 0
+			}
+		}
+
+		if start >= 0 && addr > start {
+			regions = append(regions, vm.Region{Kind: kind, Addr: start, Size: addr - start})
+		}
+	}
+	if memErr != "" {
+		return nil, fmt.Errorf("program does not fit in memory")
+	}
+
+	// Collect the labels, checking that global ones are defined only once
+	var errs []string
+	globals := make(map[string]int32)
+	definedIn := make(map[string]string)
+	locals := make([]map[string]int32, len(objs))
+	named := make(map[string]bool)
+	for i, obj := range objs {
+		locals[i] = make(map[string]int32)
+		for _, sym := range obj.Symbols {
+			base, ok := bases[i][sym.Section]
+			if !ok {
+				return nil, fmt.Errorf("%s: label %s is in a missing %s section", obj.Name, sym.Name, sym.Section)
+			}
+			pos := base + sym.Offset
+			key := strings.ToUpper(sym.Name)
+
+			if IsLocal(sym.Name) {
+				// The program keeps the first of local labels of the same name
+				locals[i][key] = pos
+				if named[key] {
+					continue
+				}
+			} else if other, dup := definedIn[key]; dup {
+				errs = append(errs, fmt.Sprintf("duplicate label %s in %s and %s", sym.Name, other, obj.Name))
+				continue
+			} else {
+				globals[key] = pos
+				definedIn[key] = obj.Name
+			}
+			named[key] = true
+			m.AddLabel(sym.Name, pos)
+		}
+	}
+
+	// Apply the relocations
+	undefined := make(map[string]bool)
+	for i, obj := range objs {
+		for _, r := range obj.Relocs {
+			base, ok := bases[i][r.Section]
+			if !ok {
+				return nil, fmt.Errorf("%s: relocation in a missing %s section", obj.Name, r.Section)
+			}
+
+			var target int32
+			if r.Target != 0 {
+				if target, ok = bases[i][r.Target]; !ok {
+					return nil, fmt.Errorf("%s: relocation against a missing %s section", obj.Name, r.Target)
+				}
+			} else {
+				key := strings.ToUpper(r.Symbol)
+				if target, ok = locals[i][key]; !ok {
+					target, ok = globals[key]
+				}
+				if !ok {
+					if msg := fmt.Sprintf("undefined label %s referenced in %s", r.Symbol, obj.Name); !undefined[msg] {
+						undefined[msg] = true
+						errs = append(errs, msg)
+					}
+					continue
+				}
+			}
+
+			word := base + r.Offset
+			m.SetMem(word, m.GetMem(word)+target)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	// Move the line tables to the final code addresses, leaving out lines
+	// past the end of the code of an object. The end of the entry object is
+	// the halt after it.
+	var lines []vm.SourceLine
+	for i, obj := range objs {
+		var size int32
+		for _, s := range obj.Sections {
+			if s.Kind == vm.SectionCode {
+				size = s.Len()
+			}
+		}
+		for _, l := range obj.Lines {
+			if l.Addr > size || l.Addr == size && i > 0 {
+				continue
+			}
+			l.Addr += bases[i][vm.SectionCode]
+			lines = append(lines, l)
+		}
+	}
+	sort.SliceStable(lines, func(a, b int) bool { return lines[a].Addr < lines[b].Addr })
+	m.SetLines(lines)
+
+	if len(regions) > 1 {
+		m.SetRegions(regions)
+	}
+	return m, nil
+}
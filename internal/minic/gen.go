@@ -0,0 +1,501 @@
+// Package minic compiles MiniC, a small C-like language of int variables,
+// functions, if, while and expressions, to stack machine assembler source.
+// Each line of MiniC becomes one line of assembler, so the line table of
+// the compiled image refers to the MiniC source.
+package minic
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+)
+
+// binaryCode is the assembler code of a binary operator applied to the
+// two values on the stack, and the library module it calls
+var binaryCode = map[string][2]string{
+	"+":  {"add", ""},
+	"-":  {"swap sub", ""},
+	"*":  {"mul", "arith"},
+	"/":  {"div", "arith"},
+	"%":  {"mod", "arith"},
+	"&":  {"and", ""},
+	"|":  {"or", ""},
+	"^":  {"xor", ""},
+	"==": {"eq", "compare"},
+	"!=": {"ne", "compare"},
+	"<":  {"lt", "compare"},
+	">":  {"gt", "compare"},
+	"<=": {"le", "compare"},
+	">=": {"ge", "compare"},
+}
+
+// builtins are the functions provided by the language, with their number
+// of arguments; -1 takes any number
+var builtins = map[string]int{
+	"print": -1,
+	"putc":  1,
+	"getc":  0,
+}
+
+type generator struct {
+	out     [][]string // Assembler tokens of each line
+	line    int        // Line code was last emitted to
+	funcs   map[string]*funcDecl
+	globals map[string]bool
+	scopes  []map[string]int32 // Local slots of the enclosing blocks
+	slots   int32              // Slots of the function being compiled
+	strs    int                // Strings printed
+	modules map[string]bool
+}
+
+// Translate compiles MiniC source to assembler source
+func Translate(r io.Reader) (string, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	tokens, err := lex(string(src))
+	if err != nil {
+		return "", err
+	}
+	p := &parser{tokens: tokens}
+	prog, err := p.parseProgram()
+	if err != nil {
+		return "", err
+	}
+
+	g := &generator{
+		funcs:   make(map[string]*funcDecl),
+		globals: make(map[string]bool),
+		modules: make(map[string]bool),
+	}
+	if err := g.program(prog); err != nil {
+		return "", err
+	}
+	return g.source(), nil
+}
+
+// source joins the translated lines, importing the library modules used
+// on the first line
+func (g *generator) source() string {
+	var imports []string
+	for module := range g.modules {
+		imports = append(imports, "import "+module)
+	}
+	sort.Strings(imports)
+	g.out[0] = append(imports, g.out[0]...)
+
+	var sb strings.Builder
+	for _, tokens := range g.out {
+		sb.WriteString(strings.Join(tokens, " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// emit appends assembler code to a line, or to the line code was last
+// emitted to if that is later, so that code stays in order when the parts
+// of a construct span lines
+func (g *generator) emit(line int, code ...string) {
+	if line < g.line {
+		line = g.line
+	}
+	for len(g.out) < line {
+		g.out = append(g.out, nil)
+	}
+	g.out[line-1] = append(g.out[line-1], code...)
+	g.line = line
+}
+
+func errorf(line int, format string, args ...interface{}) error {
+	return fmt.Errorf(format+" at line %d", append(args, line)...)
+}
+
+// label returns the label of a function or global variable. Labels are
+// case-insensitive, so upper case letters are escaped with an underscore
+// and underscores are doubled.
+func label(name string) string {
+	var sb strings.Builder
+	sb.WriteString("mc.")
+	for i := 0; i < len(name); i++ {
+		switch ch := name[i]; {
+		case ch >= 'A' && ch <= 'Z':
+			sb.WriteByte('_')
+			sb.WriteByte(ch - 'A' + 'a')
+		case ch == '_':
+			sb.WriteString("__")
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return sb.String()
+}
+
+// program compiles the program, which calls main and exits with the
+// value it returns
+func (g *generator) program(prog *program) error {
+	define := func(name string, line int) error {
+		if _, ok := builtins[name]; ok {
+			return errorf(line, "%s is a built-in function", name)
+		}
+		if g.funcs[name] != nil || g.globals[name] {
+			return errorf(line, "%s is already defined", name)
+		}
+		return nil
+	}
+
+	for _, f := range prog.funcs {
+		if err := define(f.name, f.line); err != nil {
+			return err
+		}
+		g.funcs[f.name] = f
+	}
+	main := g.funcs["main"]
+	if main == nil {
+		return errorf(1, "No main function")
+	}
+	if len(main.params) > 0 {
+		return errorf(main.line, "main takes no arguments")
+	}
+	g.emit(1, label("main"), "exit")
+
+	for _, d := range prog.globals {
+		if err := define(d.name, d.line); err != nil {
+			return err
+		}
+		val := int64(0)
+		if d.init != nil {
+			var ok bool
+			if val, ok = constValue(d.init); !ok {
+				return errorf(d.line, "Initializer of %s is not a constant", d.name)
+			}
+		}
+		g.line = 0
+		g.emit(d.line, ".data", label(d.name)+":", "embed", num(val), ".text")
+		g.globals[d.name] = true
+	}
+
+	for _, f := range prog.funcs {
+		if err := g.function(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constValue evaluates the initializer of a global variable: a number,
+// optionally negated or complemented
+func constValue(e expr) (int64, bool) {
+	switch e := e.(type) {
+	case *numExpr:
+		return e.value, true
+	case *unaryExpr:
+		val, ok := constValue(e.x)
+		switch e.op {
+		case "-":
+			return -val, ok
+		case "~":
+			return ^val, ok
+		}
+	}
+	return 0, false
+}
+
+// num formats a value as a word, wrapping numbers up to 0xffffffff to
+// negative values
+func num(val int64) string {
+	return strconv.Itoa(int(int32(val)))
+}
+
+// function compiles a function. Parameters and local variables are slots
+// of a frame opened on entry, and the arguments are taken from the stack
+// with the last on top.
+func (g *generator) function(f *funcDecl) error {
+	g.line = 0
+	g.emit(f.line, label(f.name)+":", "enter", "0")
+	enter := len(g.out[f.line-1]) - 1
+
+	g.slots = 0
+	g.scopes = []map[string]int32{{}}
+	for _, param := range f.params {
+		if _, ok := g.scopes[0][param]; ok {
+			return errorf(f.line, "Duplicate parameter %s", param)
+		}
+		g.scopes[0][param] = g.slots
+		g.slots++
+	}
+	for i := len(f.params) - 1; i >= 0; i-- {
+		g.emit(f.line, "storl", strconv.Itoa(i))
+	}
+
+	if err := g.stmt(f.body); err != nil {
+		return err
+	}
+	// Falling off the end returns 0
+	g.emit(f.body.endLine, "0", "leave", "popip")
+	g.out[f.line-1][enter] = strconv.Itoa(int(g.slots))
+	return nil
+}
+
+// local returns the slot of a local variable
+func (g *generator) local(name string) (int32, bool) {
+	for i := len(g.scopes) - 1; i >= 0; i-- {
+		if slot, ok := g.scopes[i][name]; ok {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+func (g *generator) stmt(s stmt) error {
+	switch s := s.(type) {
+	case *blockStmt:
+		g.scopes = append(g.scopes, map[string]int32{})
+		for _, s := range s.stmts {
+			if err := g.stmt(s); err != nil {
+				return err
+			}
+		}
+		g.scopes = g.scopes[:len(g.scopes)-1]
+
+	case *declStmt:
+		scope := g.scopes[len(g.scopes)-1]
+		for _, d := range s.decls {
+			if _, ok := scope[d.name]; ok {
+				return errorf(d.line, "%s is already declared", d.name)
+			}
+			if d.init != nil {
+				if err := g.expr(d.init); err != nil {
+					return err
+				}
+			} else {
+				g.emit(d.line, "0")
+			}
+			scope[d.name] = g.slots
+			g.emit(d.line, "storl", strconv.Itoa(int(g.slots)))
+			g.slots++
+		}
+
+	case *assignStmt:
+		return g.assign(s)
+
+	case *ifStmt:
+		if err := g.expr(s.cond); err != nil {
+			return err
+		}
+		g.emit(g.line, "if")
+		if err := g.stmt(s.then); err != nil {
+			return err
+		}
+		if s.els != nil {
+			g.emit(s.elseLine, "else")
+			if err := g.stmt(s.els); err != nil {
+				return err
+			}
+		}
+		g.emit(g.line, "then")
+
+	case *whileStmt:
+		g.emit(s.line, "begin")
+		if err := g.expr(s.cond); err != nil {
+			return err
+		}
+		g.emit(g.line, "while")
+		if err := g.stmt(s.body); err != nil {
+			return err
+		}
+		g.emit(g.line, "repeat")
+
+	case *returnStmt:
+		if s.value != nil {
+			if err := g.expr(s.value); err != nil {
+				return err
+			}
+		} else {
+			g.emit(s.line, "0")
+		}
+		g.emit(s.line, "leave", "popip")
+
+	case *callStmt:
+		switch s.call.name {
+		case "print":
+			return g.print(s.call)
+		case "putc":
+			if err := g.args(s.call); err != nil {
+				return err
+			}
+			g.emit(s.call.line, "out")
+			return nil
+		}
+		if err := g.expr(s.call); err != nil {
+			return err
+		}
+		g.emit(s.call.line, "drop")
+	}
+	return nil
+}
+
+// assign compiles an assignment to a local or global variable
+func (g *generator) assign(s *assignStmt) error {
+	slot, isLocal := g.local(s.name)
+	if !isLocal && !g.globals[s.name] {
+		return errorf(s.line, "Undefined variable %s", s.name)
+	}
+
+	if s.op != "=" {
+		if err := g.expr(&varExpr{name: s.name, line: s.line}); err != nil {
+			return err
+		}
+	}
+	if err := g.expr(s.value); err != nil {
+		return err
+	}
+	if s.op != "=" {
+		g.binary(s.op[:1], s.line)
+	}
+
+	if isLocal {
+		g.emit(s.line, "storl", strconv.Itoa(int(slot)))
+	} else {
+		g.emit(s.line, "&"+label(s.name), "stor")
+	}
+	return nil
+}
+
+// print compiles print, which prints its arguments separated by spaces
+// and a newline
+func (g *generator) print(call *callExpr) error {
+	for i, arg := range call.args {
+		if i > 0 {
+			g.emit(arg.exprLine(), "32", "out")
+		}
+		if s, ok := arg.(*strExpr); ok {
+			g.strs++
+			str := fmt.Sprintf("minic.str.%d", g.strs)
+			g.emit(s.line, ".data", str+":", "embed", compiler.Quote(s.text), ".text", "&"+str, "print")
+			g.modules["string"] = true
+			continue
+		}
+		if err := g.expr(arg); err != nil {
+			return err
+		}
+		g.emit(arg.exprLine(), "outnum")
+	}
+	g.emit(g.line, "10", "out")
+	return nil
+}
+
+// args compiles the arguments of a call after checking their number
+func (g *generator) args(call *callExpr) error {
+	want, ok := builtins[call.name]
+	if f := g.funcs[call.name]; f != nil {
+		want, ok = len(f.params), true
+	}
+	if !ok {
+		return errorf(call.line, "Undefined function %s", call.name)
+	}
+	if want >= 0 && len(call.args) != want {
+		return errorf(call.line, "%s takes %s but is given %d", call.name, plural(want, "argument"), len(call.args))
+	}
+
+	for _, arg := range call.args {
+		if err := g.expr(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expr compiles an expression, which leaves its value on the stack
+func (g *generator) expr(e expr) error {
+	switch e := e.(type) {
+	case *numExpr:
+		g.emit(e.line, num(e.value))
+
+	case *strExpr:
+		return errorf(e.line, "Strings can only be printed")
+
+	case *varExpr:
+		if slot, ok := g.local(e.name); ok {
+			g.emit(e.line, "loadl", strconv.Itoa(int(slot)))
+		} else if g.globals[e.name] {
+			g.emit(e.line, "&"+label(e.name), "load")
+		} else {
+			return errorf(e.line, "Undefined variable %s", e.name)
+		}
+
+	case *callExpr:
+		if e.name == "print" || e.name == "putc" {
+			return errorf(e.line, "%s does not return a value", e.name)
+		}
+		if err := g.args(e); err != nil {
+			return err
+		}
+		if e.name == "getc" {
+			g.emit(e.line, "in")
+		} else {
+			g.emit(e.line, label(e.name))
+		}
+
+	case *unaryExpr:
+		if err := g.expr(e.x); err != nil {
+			return err
+		}
+		switch e.op {
+		case "-":
+			g.emit(e.line, "0", "sub")
+		case "!":
+			g.emit(e.line, "not")
+		case "~":
+			g.emit(e.line, "compl")
+		}
+
+	case *binaryExpr:
+		if err := g.expr(e.x); err != nil {
+			return err
+		}
+		// && and || skip the right operand once the result is known
+		switch e.op {
+		case "&&":
+			g.emit(e.line, "if")
+			if err := g.expr(e.y); err != nil {
+				return err
+			}
+			g.emit(g.line, "not", "not", "else", "0", "then")
+			return nil
+		case "||":
+			g.emit(e.line, "if", "1", "else")
+			if err := g.expr(e.y); err != nil {
+				return err
+			}
+			g.emit(g.line, "not", "not", "then")
+			return nil
+		}
+		if err := g.expr(e.y); err != nil {
+			return err
+		}
+		g.binary(e.op, e.line)
+	}
+	return nil
+}
+
+// binary compiles a binary operator
+func (g *generator) binary(op string, line int) {
+	code := binaryCode[op]
+	if code[1] != "" {
+		g.modules[code[1]] = true
+	}
+	g.emit(line, code[0])
+}
+
+// plural formats a count of things
+func plural(n int, thing string) string {
+	if n == 1 {
+		return "1 " + thing
+	}
+	return fmt.Sprintf("%d %ss", n, thing)
+}
@@ -0,0 +1,156 @@
+package minic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tokenKind classifies the tokens of MiniC source
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokPunct // Operators and punctuation
+)
+
+// token is a token of MiniC source
+type token struct {
+	kind  tokenKind
+	text  string // Identifier, punctuation, or decoded string
+	value int64  // Value of a number or character literal
+	line  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of source"
+	case tokString:
+		return strconv.Quote(t.text)
+	}
+	return t.text
+}
+
+// punctuation lists the operators, longest first so that the longest match
+// is taken
+var punctuation = []string{
+	"&&", "||", "==", "!=", "<=", ">=", "+=", "-=", "*=", "/=", "%=",
+	"+", "-", "*", "/", "%", "<", ">", "=", "!", "~", "&", "|", "^",
+	"(", ")", "{", "}", ",", ";",
+}
+
+// lex splits source into tokens
+func lex(src string) ([]token, error) {
+	var tokens []token
+	line := 1
+	for i := 0; i < len(src); {
+		ch := src[i]
+		switch {
+		case ch == '\n':
+			line++
+			i++
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("Comment is not closed at line %d", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case isLetter(ch):
+			start := i
+			for i < len(src) && (isLetter(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: src[start:i], line: line})
+		case isDigit(ch):
+			start := i
+			for i < len(src) && (isLetter(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			val, err := strconv.ParseInt(src[start:i], 0, 64)
+			if err != nil || val > 1<<32-1 {
+				return nil, fmt.Errorf("Invalid number %s at line %d", src[start:i], line)
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: src[start:i], value: val, line: line})
+		case ch == '\'' || ch == '"':
+			text, n, err := quoted(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at line %d", err, line)
+			}
+			if ch == '"' {
+				tokens = append(tokens, token{kind: tokString, text: text, line: line})
+			} else if len(text) != 1 {
+				return nil, fmt.Errorf("Invalid character literal %s at line %d", src[i:i+n], line)
+			} else {
+				tokens = append(tokens, token{kind: tokNumber, text: src[i : i+n], value: int64(text[0]), line: line})
+			}
+			i += n
+		default:
+			matched := false
+			for _, p := range punctuation {
+				if strings.HasPrefix(src[i:], p) {
+					tokens = append(tokens, token{kind: tokPunct, text: p, line: line})
+					i += len(p)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("Unexpected character %q at line %d", ch, line)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokEOF, line: line}), nil
+}
+
+// quoted decodes the string or character literal at the start of s,
+// returning its text and length in s. The escapes \n, \t, \r, \0, \\, \'
+// and \" are recognized.
+func quoted(s string) (string, int, error) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == s[0]:
+			return sb.String(), i + 1, nil
+		case ch == '\n':
+			return "", 0, fmt.Errorf("Literal is not closed")
+		case ch == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case '0':
+				sb.WriteByte(0)
+			case '\\', '\'', '"':
+				sb.WriteByte(s[i])
+			default:
+				return "", 0, fmt.Errorf("Invalid escape \\%c", s[i])
+			}
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return "", 0, fmt.Errorf("Literal is not closed")
+}
+
+func isLetter(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch == '_'
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
@@ -0,0 +1,483 @@
+package minic
+
+import "fmt"
+
+// Expressions
+
+type expr interface {
+	exprLine() int
+}
+
+type numExpr struct {
+	value int64
+	line  int
+}
+
+type strExpr struct {
+	text string
+	line int
+}
+
+type varExpr struct {
+	name string
+	line int
+}
+
+type callExpr struct {
+	name string
+	args []expr
+	line int
+}
+
+type unaryExpr struct {
+	op   string
+	x    expr
+	line int
+}
+
+type binaryExpr struct {
+	op   string
+	x, y expr
+	line int
+}
+
+func (e *numExpr) exprLine() int    { return e.line }
+func (e *strExpr) exprLine() int    { return e.line }
+func (e *varExpr) exprLine() int    { return e.line }
+func (e *callExpr) exprLine() int   { return e.line }
+func (e *unaryExpr) exprLine() int  { return e.line }
+func (e *binaryExpr) exprLine() int { return e.line }
+
+// Statements
+
+type stmt interface{}
+
+type varDecl struct {
+	name string
+	init expr // nil without an initializer
+	line int
+}
+
+// declStmt declares local variables, which belong to the enclosing block
+type declStmt struct {
+	decls []*varDecl
+}
+
+type assignStmt struct {
+	name  string
+	op    string // "=" or a compound assignment such as "+="
+	value expr
+	line  int
+}
+
+type ifStmt struct {
+	cond     expr
+	then     stmt
+	els      stmt // nil without else
+	line     int
+	elseLine int
+}
+
+type whileStmt struct {
+	cond expr
+	body stmt
+	line int
+}
+
+type returnStmt struct {
+	value expr // nil to return 0
+	line  int
+}
+
+type callStmt struct {
+	call *callExpr
+}
+
+type blockStmt struct {
+	stmts   []stmt
+	line    int
+	endLine int
+}
+
+// funcDecl is a function definition
+type funcDecl struct {
+	name   string
+	params []string
+	body   *blockStmt
+	line   int
+}
+
+// program is a parsed MiniC source
+type program struct {
+	globals []*varDecl
+	funcs   []*funcDecl
+}
+
+// parser is a recursive descent parser of MiniC
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// is checks if the next token is the given punctuation or keyword
+func (p *parser) is(text string) bool {
+	t := p.peek()
+	return (t.kind == tokPunct || t.kind == tokIdent) && t.text == text
+}
+
+// accept consumes the next token if it is the given punctuation or keyword
+func (p *parser) accept(text string) bool {
+	if p.is(text) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf(format+" at line %d", append(args, t.line)...)
+}
+
+// expect consumes the given punctuation or keyword
+func (p *parser) expect(text string) (token, error) {
+	t := p.peek()
+	if !p.accept(text) {
+		return t, p.errorf(t, "Expected %s but found %s", text, t)
+	}
+	return t, nil
+}
+
+// ident consumes an identifier that is not a keyword
+func (p *parser) ident() (token, error) {
+	t := p.next()
+	if t.kind != tokIdent || keywords[t.text] {
+		return t, p.errorf(t, "Expected a name but found %s", t)
+	}
+	return t, nil
+}
+
+var keywords = map[string]bool{
+	"int": true, "if": true, "else": true, "while": true, "return": true,
+}
+
+// parseProgram parses declarations of global variables and functions
+func (p *parser) parseProgram() (*program, error) {
+	prog := &program{}
+	for p.peek().kind != tokEOF {
+		if _, err := p.expect("int"); err != nil {
+			return nil, err
+		}
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.is("(") {
+			f, err := p.parseFunc(name)
+			if err != nil {
+				return nil, err
+			}
+			prog.funcs = append(prog.funcs, f)
+			continue
+		}
+
+		decls, err := p.parseDecls(name)
+		if err != nil {
+			return nil, err
+		}
+		prog.globals = append(prog.globals, decls...)
+	}
+	return prog, nil
+}
+
+// parseFunc parses the parameters and body of a function
+func (p *parser) parseFunc(name token) (*funcDecl, error) {
+	f := &funcDecl{name: name.text, line: name.line}
+	p.expect("(")
+	for !p.accept(")") {
+		if len(f.params) > 0 {
+			if _, err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := p.expect("int"); err != nil {
+			return nil, err
+		}
+		param, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		f.params = append(f.params, param.text)
+	}
+
+	t := p.peek()
+	if !p.is("{") {
+		return nil, p.errorf(t, "Expected { but found %s", t)
+	}
+	body, err := p.parseStmt()
+	if err != nil {
+		return nil, err
+	}
+	f.body = body.(*blockStmt)
+	return f, nil
+}
+
+// parseDecls parses the rest of a declaration of variables after the
+// first name: an optional initializer, further names and the semicolon
+func (p *parser) parseDecls(name token) ([]*varDecl, error) {
+	var decls []*varDecl
+	for {
+		d := &varDecl{name: name.text, line: name.line}
+		if p.accept("=") {
+			init, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			d.init = init
+		}
+		decls = append(decls, d)
+
+		if !p.accept(",") {
+			break
+		}
+		var err error
+		if name, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.expect(";"); err != nil {
+		return nil, err
+	}
+	return decls, nil
+}
+
+// parseStmt parses a statement
+func (p *parser) parseStmt() (stmt, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokPunct && t.text == "{":
+		block := &blockStmt{line: t.line}
+		for !p.is("}") {
+			if p.peek().kind == tokEOF {
+				return nil, p.errorf(t, "Block is not closed")
+			}
+			s, err := p.parseStmt()
+			if err != nil {
+				return nil, err
+			}
+			block.stmts = append(block.stmts, s)
+		}
+		block.endLine = p.next().line
+		return block, nil
+
+	case t.kind == tokIdent && t.text == "int":
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		decls, err := p.parseDecls(name)
+		if err != nil {
+			return nil, err
+		}
+		return &declStmt{decls: decls}, nil
+
+	case t.kind == tokIdent && t.text == "if":
+		s := &ifStmt{line: t.line}
+		cond, err := p.parseCond()
+		if err != nil {
+			return nil, err
+		}
+		s.cond = cond
+		if s.then, err = p.parseStmt(); err != nil {
+			return nil, err
+		}
+		if e := p.peek(); p.accept("else") {
+			s.elseLine = e.line
+			if s.els, err = p.parseStmt(); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+
+	case t.kind == tokIdent && t.text == "while":
+		s := &whileStmt{line: t.line}
+		cond, err := p.parseCond()
+		if err != nil {
+			return nil, err
+		}
+		s.cond = cond
+		if s.body, err = p.parseStmt(); err != nil {
+			return nil, err
+		}
+		return s, nil
+
+	case t.kind == tokIdent && t.text == "return":
+		s := &returnStmt{line: t.line}
+		if !p.is(";") {
+			value, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			s.value = value
+		}
+		if _, err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		return s, nil
+
+	case t.kind == tokIdent && !keywords[t.text]:
+		var s stmt
+		if p.is("(") {
+			call, err := p.parseCall(t)
+			if err != nil {
+				return nil, err
+			}
+			s = &callStmt{call: call}
+		} else {
+			op := p.next()
+			if op.kind != tokPunct || !assignOps[op.text] {
+				return nil, p.errorf(op, "Expected an assignment or call but found %s", op)
+			}
+			value, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			s = &assignStmt{name: t.text, op: op.text, value: value, line: op.line}
+		}
+		if _, err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	return nil, p.errorf(t, "Expected a statement but found %s", t)
+}
+
+var assignOps = map[string]bool{"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true}
+
+// parseCond parses the parenthesized condition of if and while
+func (p *parser) parseCond() (expr, error) {
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+	cond, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return cond, nil
+}
+
+// parseCall parses the arguments of a call of the named function
+func (p *parser) parseCall(name token) (*callExpr, error) {
+	call := &callExpr{name: name.text, line: name.line}
+	p.expect("(")
+	for !p.accept(")") {
+		if len(call.args) > 0 {
+			if _, err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		var arg expr
+		var err error
+		if t := p.peek(); t.kind == tokString {
+			p.next()
+			arg = &strExpr{text: t.text, line: t.line}
+		} else if arg, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+	}
+	return call, nil
+}
+
+// binaryOps lists the binary operators from the lowest precedence to the
+// highest, as in C
+var binaryOps = [][]string{
+	{"||"},
+	{"&&"},
+	{"|"},
+	{"^"},
+	{"&"},
+	{"==", "!="},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// parseExpr parses an expression
+func (p *parser) parseExpr() (expr, error) {
+	return p.parseBinary(0)
+}
+
+// parseBinary parses operators of the given precedence level and higher,
+// associating to the left
+func (p *parser) parseBinary(level int) (expr, error) {
+	if level == len(binaryOps) {
+		return p.parseUnary()
+	}
+
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		matched := false
+		for _, op := range binaryOps[level] {
+			matched = matched || t.kind == tokPunct && t.text == op
+		}
+		if !matched {
+			return x, nil
+		}
+		p.next()
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryExpr{op: t.text, x: x, y: y, line: t.line}
+	}
+}
+
+// parseUnary parses prefix operators and primary expressions
+func (p *parser) parseUnary() (expr, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokPunct && (t.text == "-" || t.text == "!" || t.text == "~"):
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpr{op: t.text, x: x, line: t.line}, nil
+	case t.kind == tokPunct && t.text == "(":
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case t.kind == tokNumber:
+		return &numExpr{value: t.value, line: t.line}, nil
+	case t.kind == tokIdent && !keywords[t.text]:
+		if p.is("(") {
+			return p.parseCall(t)
+		}
+		return &varExpr{name: t.text, line: t.line}, nil
+	}
+	return nil, p.errorf(t, "Expected an expression but found %s", t)
+}
@@ -0,0 +1,235 @@
+// Package objfile reads and writes the relocatable object files that the
+// compiler writes with -c and the linker combines into a program.
+package objfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// Magic starts every object file. Objects use the vm.Container layout
+// of images, but cannot be loaded or run before they are linked.
+const Magic = "SMGO"
+
+// Version is the object format version written by Write
+const Version = 1
+
+// Object is a separately compiled program part. Its sections each start at
+// address zero; words that hold addresses are listed as relocations.
+type Object struct {
+	Name     string // File the object was read from, for messages
+	Sections []Section
+	Symbols  []Symbol
+	Relocs   []Reloc
+	Lines    []vm.SourceLine // Line table, by offset in the code section
+}
+
+// Section is the code, data or bss section of an object
+type Section struct {
+	Kind  vm.SectionKind // vm.SectionCode, vm.SectionData or vm.SectionBSS
+	Align int32          // Alignment the section must be placed at
+	Words []int32        // Contents of code and data sections
+	Size  int32          // Size in bytes of a bss section
+}
+
+// Len returns the size of the section in bytes
+func (s Section) Len() int32 {
+	if s.Kind == vm.SectionBSS {
+		return s.Size
+	}
+	return int32(len(s.Words)) * 4
+}
+
+// Symbol is a label defined in an object
+type Symbol struct {
+	Name    string
+	Section vm.SectionKind
+	Offset  int32
+}
+
+// Reloc is a word of an object that holds an address. The linker adds the
+// address of the Target section of the same object, or of the label Symbol
+// defined in any object.
+type Reloc struct {
+	Section vm.SectionKind // Section holding the word
+	Offset  int32          // Offset of the word in its section
+	Target  vm.SectionKind // Section the address points into, or zero
+	Symbol  string         // Label the address refers to if Target is zero
+}
+
+// IsObject reports whether data starts with the object file magic
+func IsObject(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(Magic))
+}
+
+// Write writes an object file. Code, data and bss sections hold
+// their alignment where images hold the load address.
+func Write(w io.Writer, obj *Object) error {
+	c := &vm.Container{Magic: Magic, Version: Version, WordSize: 4}
+
+	for _, s := range obj.Sections {
+		payload := new(bytes.Buffer)
+		if s.Kind == vm.SectionBSS {
+			binary.Write(payload, binary.LittleEndian, uint32(s.Size))
+		} else {
+			binary.Write(payload, binary.LittleEndian, s.Words)
+		}
+		c.Sections = append(c.Sections, vm.ContainerSection{Kind: s.Kind, Addr: s.Align, Payload: payload.Bytes()})
+	}
+
+	if len(obj.Symbols) > 0 {
+		syms := new(bytes.Buffer)
+		for _, sym := range obj.Symbols {
+			binary.Write(syms, binary.LittleEndian, sym.Section)
+			binary.Write(syms, binary.LittleEndian, sym.Offset)
+			if err := vm.WriteName(syms, sym.Name); err != nil {
+				return err
+			}
+		}
+		c.Sections = append(c.Sections, vm.ContainerSection{Kind: vm.SectionSymbols, Payload: syms.Bytes()})
+	}
+
+	if len(obj.Relocs) > 0 {
+		relocs := new(bytes.Buffer)
+		for _, r := range obj.Relocs {
+			binary.Write(relocs, binary.LittleEndian, r.Section)
+			binary.Write(relocs, binary.LittleEndian, r.Offset)
+			binary.Write(relocs, binary.LittleEndian, r.Target)
+			if err := vm.WriteName(relocs, r.Symbol); err != nil {
+				return err
+			}
+		}
+		c.Sections = append(c.Sections, vm.ContainerSection{Kind: vm.SectionRelocs, Payload: relocs.Bytes()})
+	}
+
+	if len(obj.Lines) > 0 {
+		c.Sections = append(c.Sections, vm.ContainerSection{Kind: vm.SectionDebug, Payload: vm.EncodeLines(obj.Lines)})
+	}
+
+	return vm.WriteContainer(w, c)
+}
+
+// Read reads an object file. name is used in messages.
+func Read(r io.Reader, name string) (*Object, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !IsObject(data) {
+		return nil, fmt.Errorf("not an object file")
+	}
+
+	c, err := vm.ReadContainer(data)
+	if err != nil {
+		return nil, fmt.Errorf("object: %v", err)
+	}
+	if c.Version != Version {
+		return nil, fmt.Errorf("unsupported object version %d", c.Version)
+	}
+	if c.WordSize != 4 {
+		return nil, fmt.Errorf("unsupported word size %d", c.WordSize)
+	}
+
+	obj := &Object{Name: name}
+	for _, sec := range c.Sections {
+		switch sec.Kind {
+		case vm.SectionCode, vm.SectionData, vm.SectionBSS:
+			s, err := readSection(sec)
+			if err != nil {
+				return nil, err
+			}
+			obj.Sections = append(obj.Sections, s)
+		case vm.SectionSymbols:
+			if obj.Symbols, err = readSymbols(sec.Payload); err != nil {
+				return nil, err
+			}
+		case vm.SectionRelocs:
+			if obj.Relocs, err = readRelocs(sec.Payload); err != nil {
+				return nil, err
+			}
+		case vm.SectionDebug:
+			if obj.Lines, err = vm.ReadLineTable(bytes.NewReader(sec.Payload)); err != nil {
+				return nil, fmt.Errorf("debug section: %v", err)
+			}
+		}
+		// Unknown sections are skipped so that newer files still load
+	}
+	return obj, nil
+}
+
+// readSection reads a code, data or bss section
+func readSection(sec vm.ContainerSection) (Section, error) {
+	if sec.Addr < 4 || sec.Addr&(sec.Addr-1) != 0 {
+		return Section{}, fmt.Errorf("%s section has invalid alignment %d", sec.Kind, sec.Addr)
+	}
+	s := Section{Kind: sec.Kind, Align: sec.Addr}
+	payload := sec.Payload
+
+	if sec.Kind == vm.SectionBSS {
+		if len(payload) != 4 {
+			return Section{}, fmt.Errorf("bss section payload must be 4 bytes")
+		}
+		s.Size = int32(binary.LittleEndian.Uint32(payload))
+		if s.Size < 0 || s.Size%4 != 0 {
+			return Section{}, fmt.Errorf("bss section size %d is not a multiple of the word size", s.Size)
+		}
+		return s, nil
+	}
+
+	if len(payload)%4 != 0 {
+		return Section{}, fmt.Errorf("%s section size %d is not a multiple of the word size", sec.Kind, len(payload))
+	}
+	s.Words = make([]int32, len(payload)/4)
+	binary.Read(bytes.NewReader(payload), binary.LittleEndian, s.Words)
+	return s, nil
+}
+
+// readSymbols reads a symbol section: per label, its section, its offset
+// in the section, the length of its name and the name
+func readSymbols(payload []byte) ([]Symbol, error) {
+	var syms []Symbol
+	r := bytes.NewReader(payload)
+	for r.Len() > 0 {
+		var sym Symbol
+		if err := binary.Read(r, binary.LittleEndian, &sym.Section); err != nil {
+			return nil, fmt.Errorf("truncated symbol section")
+		}
+		if err := binary.Read(r, binary.LittleEndian, &sym.Offset); err != nil {
+			return nil, fmt.Errorf("truncated symbol section")
+		}
+		name, err := vm.ReadName(r)
+		if err != nil {
+			return nil, fmt.Errorf("truncated symbol section")
+		}
+		sym.Name = name
+		syms = append(syms, sym)
+	}
+	return syms, nil
+}
+
+// readRelocs reads a relocation section: per word, its section, its offset
+// in the section, the target section, the length of the symbol name and
+// the name
+func readRelocs(payload []byte) ([]Reloc, error) {
+	var relocs []Reloc
+	r := bytes.NewReader(payload)
+	for r.Len() > 0 {
+		var rel Reloc
+		for _, field := range []interface{}{&rel.Section, &rel.Offset, &rel.Target} {
+			if err := binary.Read(r, binary.LittleEndian, field); err != nil {
+				return nil, fmt.Errorf("truncated relocation section")
+			}
+		}
+		name, err := vm.ReadName(r)
+		if err != nil {
+			return nil, fmt.Errorf("truncated relocation section")
+		}
+		rel.Symbol = name
+		relocs = append(relocs, rel)
+	}
+	return relocs, nil
+}
@@ -0,0 +1,37 @@
+// Package stdlib holds the standard library of the stack machine, compiled
+// into smg so that programs can import it without knowing where it is
+// installed.
+package stdlib
+
+import (
+	"embed"
+	"sort"
+	"strings"
+)
+
+//go:embed *.src
+var files embed.FS
+
+// Source returns the source of a library module, named without the .src
+// extension
+func Source(name string) ([]byte, bool) {
+	if name == "" || strings.ContainsAny(name, "/\\.") {
+		return nil, false
+	}
+	data, err := files.ReadFile(name + ".src")
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Modules returns the names of the library modules
+func Modules() []string {
+	entries, _ := files.ReadDir(".")
+	var names []string
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".src"))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,173 @@
+package stdlib_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/stdlib"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// driver is a program that imports library modules, calls their functions
+// and halts, leaving the results on the stack
+type driver struct {
+	name  string
+	src   string
+	input string
+	out   string
+	stack []int32
+}
+
+var drivers = []driver{
+	// compare
+	{name: "eq", src: "import compare 7 7 eq 7 8 eq", stack: []int32{1, 0}},
+	{name: "ne", src: "import compare 7 7 ne 7 8 ne", stack: []int32{0, 1}},
+	{name: "zero?", src: "import compare 0 zero? 5 zero?", stack: []int32{1, 0}},
+	{name: "neg?", src: "import compare -1 neg? 0 neg?", stack: []int32{1, 0}},
+	{name: "lt", src: "import compare 3 5 lt 5 3 lt 4 4 lt", stack: []int32{1, 0, 0}},
+	{name: "lt overflow", src: "import compare -2147483648 1 lt 2147483647 -1 lt", stack: []int32{1, 0}},
+	{name: "gt le ge", src: "import compare 5 3 gt 4 4 le 3 4 ge", stack: []int32{1, 1, 0}},
+	{name: "ult ugt", src: "import compare -1 1 ult 1 -1 ult -1 1 ugt", stack: []int32{0, 1, 1}},
+	{name: "min max", src: "import compare 3 -4 min 3 -4 max", stack: []int32{-4, 3}},
+
+	// arith
+	{name: "inc dec", src: "import arith 41 inc 43 dec", stack: []int32{42, 42}},
+	{name: "negate abs", src: "import arith 5 negate -9 abs 9 abs", stack: []int32{-5, 9, 9}},
+	{name: "mul", src: "import arith 6 7 mul -3 5 mul 65536 65536 mul", stack: []int32{42, -15, 0}},
+	{name: "divmod", src: "import arith 7 2 divmod -7 2 divmod 7 -2 divmod", stack: []int32{3, 1, -3, -1, -3, 1}},
+	{name: "div mod", src: "import arith 100 7 div 100 7 mod", stack: []int32{14, 2}},
+	{name: "divide by zero", src: `
+import arith
+  try &failed 1 0 div endtry
+  halt
+failed:
+`, stack: []int32{-10}},
+
+	// string
+	{name: "print", src: `
+import string
+  &main jmp
+msg: embed "hi"
+main:
+  &msg print space &msg println
+`, out: "hi hi\n"},
+	{name: "strlen", src: `
+import string
+  &main jmp
+empty: embed ""
+msg: embed "hello"
+main:
+  &msg strlen &empty strlen
+`, stack: []int32{5, 0}},
+
+	// parse
+	{name: "parse-num", src: `
+import parse
+  &main jmp
+neg: embed "-42"
+bad: embed "12x"
+empty: embed ""
+main:
+  &neg parse-num &bad parse-num &empty parse-num
+`, stack: []int32{-42, 1, 12, 0, 0, 0}},
+	{name: "read-num", src: "import parse read-num read-num read-num", input: "  17\n-3 x", stack: []int32{17, 1, -3, 1, 0, 0}},
+
+	// mem
+	{name: "fill memcpy", src: `
+import mem
+  &main jmp
+src: .word 0 0 0
+dst: .word 0 0 0 0
+main:
+  &src 3 7 fill
+  &src &dst 2 memcpy
+  &dst load &dst 4 add load &dst 8 add load
+`, stack: []int32{7, 7, 0}},
+	{name: "alloc", src: `
+import mem
+  8 alloc 5 alloc sub
+  heap-free
+  65536 alloc
+  heap-reset heap-free
+`, stack: []int32{8, 65520, 0, 65536}},
+
+	// std
+	{name: "std", src: "import std 6 7 mul outnum cr 3 9 max", out: "42\n", stack: []int32{9}},
+}
+
+// run compiles a driver and runs it on the VM
+func run(t *testing.T, d driver) (string, []int32) {
+	t.Helper()
+
+	var errs []string
+	c := compiler.NewCompiler(func(msg string) { errs = append(errs, msg) })
+	c.SetFile(d.name + ".src")
+	if err := c.CompileSource(strings.NewReader(d.src)); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatal(strings.Join(errs, "\n"))
+	}
+	var image bytes.Buffer
+	if err := c.GetProgram().SaveImage(&image); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	var fault string
+	m := vm.NewMachineWithSize(1000*1024, &out, strings.NewReader(d.input), func(msg string) {
+		panic(fmt.Sprintf("fault: %s", msg))
+	})
+	if err := m.LoadImage(&image); err != nil {
+		t.Fatal(err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fault = fmt.Sprint(r)
+			}
+		}()
+		m.Run(m.Entry())
+	}()
+	if fault != "" {
+		t.Fatalf("%s; output %q", fault, out.String())
+	}
+	return out.String(), m.Stack()
+}
+
+// TestModules runs drivers calling each function of the library
+func TestModules(t *testing.T) {
+	for _, d := range drivers {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			out, stack := run(t, d)
+			if out != d.out {
+				t.Errorf("output is %q, want %q", out, d.out)
+			}
+			if len(stack) == 0 {
+				stack = nil
+			}
+			if !reflect.DeepEqual(stack, d.stack) {
+				t.Errorf("stack is %v, want %v", stack, d.stack)
+			}
+		})
+	}
+}
+
+// TestSource checks the module names the compiler looks up
+func TestSource(t *testing.T) {
+	for _, name := range stdlib.Modules() {
+		if _, ok := stdlib.Source(name); !ok {
+			t.Errorf("module %s has no source", name)
+		}
+	}
+	for _, name := range []string{"", "std.src", "../std", "missing"} {
+		if _, ok := stdlib.Source(name); ok {
+			t.Errorf("Source(%q) found a module", name)
+		}
+	}
+}
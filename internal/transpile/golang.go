@@ -0,0 +1,187 @@
+package transpile
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// Options controls code generation
+type Options struct {
+	Name       string // Program name used in runtime error messages
+	Entry      int32  // Entry address
+	TrapFaults bool   // Turn runtime faults into catchable throws
+}
+
+// goFallThrough maps instructions that continue with the next instruction
+// to the runtime call implementing them
+var goFallThrough = map[vm.Op]string{
+	vm.NOP:    "",
+	vm.ADD:    "m.add()",
+	vm.SUB:    "m.sub()",
+	vm.AND:    "m.and()",
+	vm.OR:     "m.or()",
+	vm.XOR:    "m.xor()",
+	vm.NOT:    "m.not()",
+	vm.COMPL:  "m.compl()",
+	vm.IN:     "m.input()",
+	vm.OUT:    "m.output()",
+	vm.OUTNUM: "m.outnum()",
+	vm.LOAD:   "m.load()",
+	vm.STOR:   "m.stor()",
+	vm.DROP:   "m.drop()",
+	vm.DUP:    "m.dup()",
+	vm.SWAP:   "m.swap()",
+	vm.ROL3:   "m.rol3()",
+	vm.DROPIP: "m.dropIP()",
+	vm.ENDTRY: "m.endTry()",
+	vm.LEAVE:  "m.leave()",
+	vm.PUSH:   "m.push(%d)",
+	vm.PUSHIP: "m.pushIP(%d)",
+	vm.TRY:    "m.try(%d)",
+	vm.ENTER:  "m.enter(%d)",
+	vm.LOADL:  "m.loadL(%d)",
+	vm.STORL:  "m.storL(%d)",
+}
+
+// Go writes a standalone Go program that behaves like running image on the
+// VM. Every instruction reachable from the entry point becomes a case of a
+// dispatch switch, with consecutive instructions falling through to each
+// other; jumps to addresses that were not translated are interpreted, as
+// are instructions whose words the program has overwritten with STOR.
+func Go(w io.Writer, image []int32, opts Options) error {
+	bw := new(bytes.Buffer)
+	code := vm.Reachable(image, opts.Entry)
+
+	fmt.Fprintf(bw, "// Code generated by smg transpile from %s. DO NOT EDIT.\n\n", opts.Name)
+	fmt.Fprintf(bw, "package main\n\n")
+	fmt.Fprintf(bw, "import (\n\t\"bufio\"\n\t\"fmt\"\n\t\"io\"\n\t\"os\"\n)\n\n")
+
+	fmt.Fprintf(bw, "const (\n")
+	fmt.Fprintf(bw, "\tprogramName = %q\n", opts.Name)
+	fmt.Fprintf(bw, "\ttrapFaults  = %t\n", opts.TrapFaults)
+	fmt.Fprintf(bw, "\tentry       = %#x\n", opts.Entry)
+	fmt.Fprintf(bw, ")\n\n")
+
+	fmt.Fprintf(bw, "const (\n")
+	for op := vm.NOP; op < vm.NOP_END; op++ {
+		fmt.Fprintf(bw, "\top%s = %d\n", op, op)
+	}
+	fmt.Fprintf(bw, ")\n\n")
+
+	fmt.Fprintf(bw, "var image = []int32{")
+	for i, word := range image {
+		if i%8 == 0 {
+			fmt.Fprintf(bw, "\n\t")
+		} else {
+			fmt.Fprintf(bw, " ")
+		}
+		fmt.Fprintf(bw, "%d,", word)
+	}
+	fmt.Fprintf(bw, "\n}\n")
+
+	io.WriteString(bw, goRuntime)
+
+	fmt.Fprintf(bw, "\nfunc (m *machine) run() int {\n")
+	fmt.Fprintf(bw, "\tpc := int32(entry)\n")
+	fmt.Fprintf(bw, "\tfor m.running {\n")
+	fmt.Fprintf(bw, "\t\tif trapFaults && m.faultPending {\n")
+	fmt.Fprintf(bw, "\t\t\tpc = m.throwPending()\n")
+	fmt.Fprintf(bw, "\t\t\tcontinue\n")
+	fmt.Fprintf(bw, "\t\t}\n")
+	fmt.Fprintf(bw, "\t\tif m.stale(pc) {\n")
+	fmt.Fprintf(bw, "\t\t\tpc = m.step(pc)\n")
+	fmt.Fprintf(bw, "\t\t\tcontinue\n")
+	fmt.Fprintf(bw, "\t\t}\n\n")
+	fmt.Fprintf(bw, "\t\tswitch pc {\n")
+
+	fallenInto := false
+	for i, in := range code {
+		fallThrough := i+1 < len(code) && code[i+1].Addr == in.Next()
+		writeGoCase(bw, in, fallenInto, fallThrough)
+		_, continues := goFallThrough[in.Op]
+		fallenInto = fallThrough && continues
+	}
+
+	fmt.Fprintf(bw, "\t\tdefault:\n")
+	fmt.Fprintf(bw, "\t\t\tpc = m.step(pc)\n")
+	fmt.Fprintf(bw, "\t\t}\n")
+	fmt.Fprintf(bw, "\t}\n")
+	fmt.Fprintf(bw, "\treturn m.exitCode\n")
+	fmt.Fprintf(bw, "}\n")
+
+	src, err := format.Source(bw.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+// writeGoCase writes the dispatch case for one instruction. If the next
+// case is the following instruction, control falls through to it. A case
+// that can be fallen into returns to the dispatch loop if its code is
+// stale, which the loop checks for cases it dispatches to.
+func writeGoCase(w io.Writer, in vm.Instruction, fallenInto, fallThrough bool) {
+	fmt.Fprintf(w, "\t\tcase %#x: // %s", in.Addr, in.Op)
+	if in.Op.HasOperand() {
+		fmt.Fprintf(w, " %#x", in.Operand)
+	}
+	fmt.Fprintf(w, "\n")
+
+	if fallenInto {
+		fmt.Fprintf(w, "\t\t\tif m.patched && m.dirty[%d] {\n", in.Addr/4)
+		fmt.Fprintf(w, "\t\t\t\tpc = %#x\n", in.Addr)
+		fmt.Fprintf(w, "\t\t\t\tcontinue\n")
+		fmt.Fprintf(w, "\t\t\t}\n")
+	}
+
+	if call, ok := goFallThrough[in.Op]; ok {
+		if call != "" {
+			if in.Op.HasOperand() {
+				call = fmt.Sprintf(call, in.Operand)
+			}
+			fmt.Fprintf(w, "\t\t\t%s\n", call)
+
+			// Pushes cannot fault; anything else may need to unwind
+			if in.Op != vm.PUSH && in.Op != vm.PUSHIP && in.Op != vm.TRY {
+				fmt.Fprintf(w, "\t\t\tif trapFaults && m.faultPending {\n")
+				fmt.Fprintf(w, "\t\t\t\tcontinue\n")
+				fmt.Fprintf(w, "\t\t\t}\n")
+			}
+		}
+		if fallThrough {
+			fmt.Fprintf(w, "\t\t\tfallthrough\n")
+		} else {
+			fmt.Fprintf(w, "\t\t\tpc = %#x\n", in.Next())
+		}
+		return
+	}
+
+	var next string
+	switch in.Op {
+	case vm.JMP:
+		next = fmt.Sprintf("m.jmp(%#x)", in.Addr)
+	case vm.JZ:
+		next = fmt.Sprintf("m.jz(%#x)", in.Addr)
+	case vm.JNZ:
+		next = fmt.Sprintf("m.jnz(%#x)", in.Addr)
+	case vm.POPIP, vm.RET:
+		next = fmt.Sprintf("m.ret(%#x, %q)", in.Addr, in.Op.String())
+	case vm.CALL:
+		next = fmt.Sprintf("m.call(%#x, %#x)", in.Addr, in.Operand)
+	case vm.CALLS:
+		next = fmt.Sprintf("m.calls(%#x)", in.Addr)
+	case vm.THROW:
+		next = "m.throw(m.pop())"
+	case vm.EXIT:
+		next = fmt.Sprintf("m.exit(%#x)", in.Addr)
+	default:
+		next = fmt.Sprintf("m.unknown(%#x, %d)", in.Addr, in.Op)
+	}
+	fmt.Fprintf(w, "\t\t\tpc = %s\n", next)
+}
@@ -0,0 +1,181 @@
+package transpile
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/compiler"
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// selfModifying keeps the argument of _dup in the first word of _dup, as
+// the baseline core.src did, so the second call runs the stored 5 as XOR
+const selfModifying = `
+5 _dup outnum 32 out outnum 10 out
+1 13 _dup outnum 32 out outnum 10 out
+halt
+
+_dup: nop &_dup stor &_dup load &_dup load popip
+`
+
+// patchedOperand overwrites the operand of a PUSH before running it
+const patchedOperand = `
+&loop jmp
+value: .word 0
+loop:
+  &value load 1 add dup &value stor
+  &patch 4 add stor
+  patch: 0 outnum 10 out
+  &value load 3 swap sub &loop swap jnz
+halt
+`
+
+// fault is raised by the VM error callback to stop the machine, as the
+// process exit of smg run does
+type fault string
+
+// compileSource compiles assembler source to an image as smg compile
+// writes it
+func compileSource(t *testing.T, name, src string) []byte {
+	t.Helper()
+
+	var errs []string
+	c := compiler.NewCompiler(func(msg string) { errs = append(errs, msg) })
+	c.SetFile(name)
+	if err := c.CompileSource(strings.NewReader(src)); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("%s: %s", name, strings.Join(errs, "\n"))
+	}
+
+	var image bytes.Buffer
+	if err := c.GetProgram().SaveImage(&image); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return image.Bytes()
+}
+
+// loadImage loads an image into a machine writing to out, whose runtime
+// faults stop it with status 70
+func loadImage(t *testing.T, image []byte, out *bytes.Buffer) *vm.VM {
+	t.Helper()
+
+	m := vm.NewMachineWithSize(1000*1024, out, strings.NewReader(""), func(msg string) {
+		panic(fault(msg))
+	})
+	if err := m.LoadImage(bytes.NewReader(image)); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// runVM runs an image on the VM and returns its output and exit status
+func runVM(t *testing.T, image []byte) (output string, status int) {
+	t.Helper()
+
+	var out bytes.Buffer
+	m := loadImage(t, image, &out)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(fault); !ok {
+				panic(r)
+			}
+			output, status = out.String(), vm.FaultStatus
+		}
+	}()
+	status = vm.ProcessStatus(m.Run(m.Entry()))
+	return out.String(), status
+}
+
+// runGo translates an image to Go, builds it and returns the output and
+// exit status of the binary
+func runGo(t *testing.T, name string, image []byte) (string, int) {
+	t.Helper()
+
+	m := loadImage(t, image, new(bytes.Buffer))
+	var src bytes.Buffer
+	if err := Go(&src, m.Image(), Options{Name: name, Entry: m.Entry()}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module prog\n\ngo 1.24\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	build := exec.Command("go", "build", "-o", "prog")
+	build.Dir = dir
+	build.Env = append(os.Environ(), "GOWORK=off")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	var out bytes.Buffer
+	prog := exec.Command(filepath.Join(dir, "prog"))
+	prog.Stdin = strings.NewReader("")
+	prog.Stdout = &out
+	err := prog.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return out.String(), exitErr.ExitCode()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.String(), 0
+}
+
+// TestGoMatchesVM runs every example program, and programs that modify
+// their own code, on the VM and as translated Go programs and compares
+// their output and exit status
+func TestGoMatchesVM(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a Go program for each example")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	sources := map[string]string{
+		"self-modifying.src":  selfModifying,
+		"patched-operand.src": patchedOperand,
+		"exit-256.src":        "256 exit\n",
+	}
+	files, err := filepath.Glob("../../programs/*.src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources[file] = string(src)
+	}
+
+	for name, src := range sources {
+		name, src := name, src
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			t.Parallel()
+
+			image := compileSource(t, name, src)
+			wantOut, wantStatus := runVM(t, image)
+			gotOut, gotStatus := runGo(t, name, image)
+			if gotOut != wantOut {
+				t.Errorf("output is %q, VM prints %q", gotOut, wantOut)
+			}
+			if gotStatus != wantStatus {
+				t.Errorf("exit status is %d, VM exits with %d", gotStatus, wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,498 @@
+package transpile
+
+// goRuntime is the machine emulation shared by every generated Go program.
+// It mirrors the semantics of vm.VM instruction by instruction; translated
+// code calls it for each instruction and falls back to step for addresses
+// that were not translated.
+const goRuntime = `
+const (
+	memSize       = 1000 * 1024
+	faultExitCode = 70
+)
+
+type handler struct {
+	addr    int32
+	stackSP int
+	ipSP    int
+	frameSP int
+	fp      int32
+}
+
+type machine struct {
+	mem          []int32
+	stack        []int32
+	stackIP      []int32
+	frames       []int32
+	fp           int32
+	handlers     []handler
+	in           *bufio.Reader
+	out          *bufio.Writer
+	running      bool
+	exitCode     int
+	faultPending bool
+	faultCode    int32
+	patched      bool   // Code of the image has been overwritten
+	dirty        []bool // Image words whose translated code is stale
+}
+
+func newMachine() *machine {
+	m := &machine{
+		mem:     make([]int32, memSize),
+		in:      bufio.NewReader(os.Stdin),
+		out:     bufio.NewWriter(os.Stdout),
+		running: true,
+		dirty:   make([]bool, len(image)),
+	}
+	for i, w := range image {
+		m.mem[i*4] = w
+	}
+	return m
+}
+
+func main() {
+	m := newMachine()
+	status := m.run()
+	m.out.Flush()
+	if status != 0 && status&0xff == 0 {
+		status = 1 // Keep a failure from looking like success
+	}
+	os.Exit(status & 0xff)
+}
+
+func next(ip int32) int32 {
+	ip += 4
+	if ip >= memSize {
+		ip = 0
+	}
+	return ip
+}
+
+func (m *machine) error(msg string) {
+	m.out.Flush()
+	fmt.Fprintf(os.Stderr, "%s:%s\n", programName, msg)
+	os.Exit(faultExitCode)
+}
+
+func (m *machine) fault(code int32, msg string) {
+	if trapFaults && len(m.handlers) > 0 {
+		if !m.faultPending {
+			m.faultPending = true
+			m.faultCode = code
+		}
+		return
+	}
+	m.error(msg)
+}
+
+func (m *machine) throw(code int32) int32 {
+	if len(m.handlers) == 0 {
+		m.error(fmt.Sprintf("uncaught THROW %d", code))
+	}
+
+	h := m.handlers[len(m.handlers)-1]
+	m.handlers = m.handlers[:len(m.handlers)-1]
+
+	if len(m.stack) > h.stackSP {
+		m.stack = m.stack[:h.stackSP]
+	}
+	if len(m.stackIP) > h.ipSP {
+		m.stackIP = m.stackIP[:h.ipSP]
+	}
+	if len(m.frames) > h.frameSP {
+		m.frames = m.frames[:h.frameSP]
+	}
+	m.fp = h.fp
+
+	if h.addr < 0 || h.addr >= memSize {
+		m.error("THROW handler out of bounds")
+	}
+
+	m.push(code)
+	return h.addr
+}
+
+func (m *machine) throwPending() int32 {
+	m.faultPending = false
+	return m.throw(m.faultCode)
+}
+
+func (m *machine) checkBounds(n int32, msg string) bool {
+	if n < 0 || n >= memSize {
+		m.fault(-3, msg)
+		return false
+	}
+	return true
+}
+
+func (m *machine) push(n int32) {
+	m.stack = append(m.stack, n)
+}
+
+func (m *machine) pop() int32 {
+	if len(m.stack) == 0 {
+		m.fault(-1, "POP empty stack")
+		return 0
+	}
+	n := m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	return n
+}
+
+func (m *machine) pushIP(n int32) {
+	m.stackIP = append(m.stackIP, n)
+}
+
+func (m *machine) popIP() int32 {
+	if len(m.stackIP) == 0 {
+		m.fault(-2, "POP empty IP stack")
+		return 0
+	}
+	n := m.stackIP[len(m.stackIP)-1]
+	m.stackIP = m.stackIP[:len(m.stackIP)-1]
+	return n
+}
+
+func (m *machine) add() {
+	b := m.pop()
+	a := m.pop()
+	m.push(a + b)
+}
+
+func (m *machine) sub() {
+	b := m.pop()
+	a := m.pop()
+	m.push(b - a)
+}
+
+func (m *machine) and() {
+	b := m.pop()
+	a := m.pop()
+	m.push(a & b)
+}
+
+func (m *machine) or() {
+	b := m.pop()
+	a := m.pop()
+	m.push(a | b)
+}
+
+func (m *machine) xor() {
+	b := m.pop()
+	a := m.pop()
+	m.push(a ^ b)
+}
+
+func (m *machine) not() {
+	if m.pop() == 0 {
+		m.push(1)
+	} else {
+		m.push(0)
+	}
+}
+
+func (m *machine) compl() {
+	m.push(^m.pop())
+}
+
+func (m *machine) input() {
+	m.out.Flush()
+	b, err := m.in.ReadByte()
+	if err != nil {
+		if err != io.EOF {
+			m.error("IN: " + err.Error())
+		}
+		m.push(0)
+	} else {
+		m.push(int32(b))
+	}
+}
+
+func (m *machine) output() {
+	m.out.WriteByte(byte(m.pop()))
+}
+
+func (m *machine) outnum() {
+	fmt.Fprintf(m.out, "%d", m.pop())
+}
+
+func (m *machine) load() {
+	addr := m.pop()
+	if m.checkBounds(addr, "LOAD") {
+		m.push(m.mem[addr])
+	}
+}
+
+func (m *machine) stor() {
+	addr := m.pop()
+	val := m.pop()
+	if m.checkBounds(addr, "STOR") {
+		m.mem[addr] = val
+		m.patch(addr)
+	}
+}
+
+// patch marks the translated code of a word written by STOR as stale,
+// along with the instruction before it, whose operand the word may be
+func (m *machine) patch(addr int32) {
+	i := addr / 4
+	if addr%4 != 0 || int(i) >= len(m.dirty) {
+		return
+	}
+	m.dirty[i] = true
+	if i > 0 {
+		m.dirty[i-1] = true
+	}
+	m.patched = true
+}
+
+// stale checks if the code translated for pc no longer matches memory, so
+// that the instruction must be interpreted
+func (m *machine) stale(pc int32) bool {
+	return m.patched && pc%4 == 0 && int(pc/4) < len(m.dirty) && m.dirty[pc/4]
+}
+
+func (m *machine) drop() {
+	m.pop()
+}
+
+func (m *machine) dup() {
+	a := m.pop()
+	m.push(a)
+	m.push(a)
+}
+
+func (m *machine) swap() {
+	b := m.pop()
+	a := m.pop()
+	m.push(b)
+	m.push(a)
+}
+
+func (m *machine) rol3() {
+	c := m.pop()
+	b := m.pop()
+	a := m.pop()
+	m.push(b)
+	m.push(c)
+	m.push(a)
+}
+
+func (m *machine) dropIP() {
+	m.popIP()
+}
+
+func (m *machine) try(addr int32) {
+	m.handlers = append(m.handlers, handler{
+		addr:    addr,
+		stackSP: len(m.stack),
+		ipSP:    len(m.stackIP),
+		frameSP: len(m.frames),
+		fp:      m.fp,
+	})
+}
+
+func (m *machine) endTry() {
+	if len(m.handlers) == 0 {
+		m.error("ENDTRY without TRY")
+	}
+	m.handlers = m.handlers[:len(m.handlers)-1]
+}
+
+func (m *machine) enter(n int32) {
+	if n < 0 {
+		m.fault(-5, "ENTER negative frame size")
+		return
+	}
+	m.frames = append(m.frames, m.fp)
+	m.fp = int32(len(m.frames))
+	m.frames = append(m.frames, make([]int32, n)...)
+}
+
+func (m *machine) leave() {
+	if m.fp == 0 {
+		m.fault(-5, "LEAVE without frame")
+		return
+	}
+	saved := m.frames[m.fp-1]
+	m.frames = m.frames[:m.fp-1]
+	m.fp = saved
+}
+
+func (m *machine) local(i int32, msg string) int32 {
+	if m.fp == 0 || i < 0 || int(m.fp+i) >= len(m.frames) {
+		m.fault(-5, msg)
+		return -1
+	}
+	return m.fp + i
+}
+
+func (m *machine) loadL(i int32) {
+	if n := m.local(i, "LOADL"); n != -1 {
+		m.push(m.frames[n])
+	}
+}
+
+func (m *machine) storL(i int32) {
+	val := m.pop()
+	if n := m.local(i, "STORL"); n != -1 {
+		m.frames[n] = val
+	}
+}
+
+func (m *machine) jmp(ip int32) int32 {
+	addr := m.pop()
+	if !m.checkBounds(addr, "JMP") {
+		return next(ip)
+	}
+	if addr == ip {
+		m.running = false
+		return ip
+	}
+	return addr
+}
+
+func (m *machine) jz(ip int32) int32 {
+	pred := m.pop()
+	addr := m.pop()
+	if pred != 0 || !m.checkBounds(addr, "JZ") {
+		return next(ip)
+	}
+	return addr
+}
+
+func (m *machine) jnz(ip int32) int32 {
+	pred := m.pop()
+	addr := m.pop()
+	if pred == 0 || !m.checkBounds(addr, "JNZ") {
+		return next(ip)
+	}
+	return addr
+}
+
+func (m *machine) ret(ip int32, msg string) int32 {
+	addr := m.popIP()
+	if m.checkBounds(addr, msg) {
+		return addr
+	}
+	return next(ip)
+}
+
+func (m *machine) call(ip, addr int32) int32 {
+	ip = next(ip)
+	if m.checkBounds(addr, "CALL") {
+		m.pushIP(ip + 4)
+		return addr
+	}
+	return next(ip)
+}
+
+func (m *machine) calls(ip int32) int32 {
+	addr := m.pop()
+	if m.checkBounds(addr, "CALLS") {
+		m.pushIP(ip + 4)
+		return addr
+	}
+	return next(ip)
+}
+
+func (m *machine) exit(ip int32) int32 {
+	status := m.pop()
+	if status&0xff == faultExitCode {
+		m.fault(-6, fmt.Sprintf("EXIT status %d is reserved for runtime faults", status))
+	}
+	m.exitCode = int(status)
+	if !m.faultPending {
+		m.running = false
+	}
+	return ip
+}
+
+func (m *machine) unknown(ip int32, op int32) int32 {
+	m.fault(-4, fmt.Sprintf("Unknown instruction: %d", op))
+	return ip
+}
+
+// step interprets the instruction at ip and returns the next ip
+func (m *machine) step(ip int32) int32 {
+	op := m.mem[ip]
+	switch op {
+	case opNOP:
+	case opADD:
+		m.add()
+	case opSUB:
+		m.sub()
+	case opAND:
+		m.and()
+	case opOR:
+		m.or()
+	case opXOR:
+		m.xor()
+	case opNOT:
+		m.not()
+	case opCOMPL:
+		m.compl()
+	case opIN:
+		m.input()
+	case opOUT:
+		m.output()
+	case opOUTNUM:
+		m.outnum()
+	case opLOAD:
+		m.load()
+	case opSTOR:
+		m.stor()
+	case opDROP:
+		m.drop()
+	case opDUP:
+		m.dup()
+	case opSWAP:
+		m.swap()
+	case opROL3:
+		m.rol3()
+	case opDROPIP:
+		m.dropIP()
+	case opENDTRY:
+		m.endTry()
+	case opLEAVE:
+		m.leave()
+	case opPUSH, opPUSHIP, opTRY, opENTER, opLOADL, opSTORL:
+		ip = next(ip)
+		n := m.mem[ip]
+		switch op {
+		case opPUSH:
+			m.push(n)
+		case opPUSHIP:
+			m.pushIP(n)
+		case opTRY:
+			m.try(n)
+		case opENTER:
+			m.enter(n)
+		case opLOADL:
+			m.loadL(n)
+		case opSTORL:
+			m.storL(n)
+		}
+	case opJMP:
+		return m.jmp(ip)
+	case opJZ:
+		return m.jz(ip)
+	case opJNZ:
+		return m.jnz(ip)
+	case opPOPIP:
+		return m.ret(ip, "POPIP")
+	case opRET:
+		return m.ret(ip, "RET")
+	case opCALL:
+		return m.call(ip, m.mem[next(ip)])
+	case opCALLS:
+		return m.calls(ip)
+	case opTHROW:
+		return m.throw(m.pop())
+	case opEXIT:
+		return m.exit(ip)
+	default:
+		return m.unknown(ip, op)
+	}
+	return next(ip)
+}
+`
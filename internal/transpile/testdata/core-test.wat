@@ -0,0 +1,1007 @@
+;; Generated by smg from core-test.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c8\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\3d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\58\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\3d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $i304
+    block $i2fc
+    block $i2f8
+    block $i2f0
+    block $i2ec
+    block $i2e4
+    block $i2dc
+    block $i2d4
+    block $i2d0
+    block $i2c8
+    block $i2c4
+    block $i2bc
+    block $i2b8
+    block $i2b0
+    block $i2ac
+    block $i2a4
+    block $i2a0
+    block $i298
+    block $i294
+    block $i28c
+    block $i284
+    block $i27c
+    block $i274
+    block $i26c
+    block $i264
+    block $i25c
+    block $i254
+    block $i24c
+    block $i244
+    block $i240
+    block $i238
+    block $i234
+    block $i22c
+    block $i228
+    block $i220
+    block $i21c
+    block $i214
+    block $i210
+    block $i208
+    block $i204
+    block $i1fc
+    block $i1f8
+    block $i1f0
+    block $i1ec
+    block $i1e4
+    block $i1e0
+    block $i1d8
+    block $i1d4
+    block $i1cc
+    block $i1c8
+    block $i18c
+    block $i188
+    block $i180
+    block $i17c
+    block $i174
+    block $i170
+    block $i168
+    block $i160
+    block $i158
+    block $i150
+    block $i148
+    block $i140
+    block $i138
+    block $i130
+    block $i128
+    block $i120
+    block $i118
+    block $i114
+    block $i10c
+    block $i104
+    block $i100
+    block $ifc
+    block $if4
+    block $if0
+    block $iec
+    block $ie8
+    block $ie0
+    block $idc
+    block $id4
+    block $ic8
+    block $ic4
+    block $i7c
+    block $i78
+    block $i70
+    block $i68
+    block $i60
+    block $i58
+    block $i8
+    block $i4
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $i4 $i8 $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $i58 $default $i60 $default $i68 $default $i70 $default $i78 $i7c $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $ic4 $ic8 $default $default $id4 $default $idc $ie0 $default $ie8 $iec $if0 $if4 $default $ifc $i100 $i104 $default $i10c $default $i114 $i118 $default $i120 $default $i128 $default $i130 $default $i138 $default $i140 $default $i148 $default $i150 $default $i158 $default $i160 $default $i168 $default $i170 $i174 $default $i17c $i180 $default $i188 $i18c $default $default $default $default $default $default $default $default $default $default $default $default $default $default $i1c8 $i1cc $default $i1d4 $i1d8 $default $i1e0 $i1e4 $default $i1ec $i1f0 $default $i1f8 $i1fc $default $i204 $i208 $default $i210 $i214 $default $i21c $i220 $default $i228 $i22c $default $i234 $i238 $default $i240 $i244 $default $i24c $default $i254 $default $i25c $default $i264 $default $i26c $default $i274 $default $i27c $default $i284 $default $i28c $default $i294 $i298 $default $i2a0 $i2a4 $default $i2ac $i2b0 $default $i2b8 $i2bc $default $i2c4 $i2c8 $default $i2d0 $i2d4 $default $i2dc $default $i2e4 $default $i2ec $i2f0 $default $i2f8 $i2fc $default $i304 $default $default $default $default
+    end
+      ;; 0x0 PUSH 0x1c8
+      i32.const 456
+      call $push
+      i32.const 8
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 8
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x58 ENTER 0x1
+      i32.const 1
+      call $enter
+    end
+      ;; 0x60 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x68 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x70 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x78 LEAVE
+      call $leave
+    end
+      ;; 0x7c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xc8 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd4 CALL 0x104
+      i32.const 220
+      call $puship
+      i32.const 260
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdc POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe0 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xe8 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xec SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0xf0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xfc ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x100 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x104 ENTER 0x3
+      i32.const 3
+      call $enter
+    end
+      ;; 0x10c STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x114 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x118 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x120 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x128 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x130 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x138 CALL 0xc4
+      i32.const 320
+      call $puship
+      i32.const 196
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x140 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x148 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x150 CALL 0xe0
+      i32.const 344
+      call $puship
+      i32.const 224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x158 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x160 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x168 PUSH 0x128
+      i32.const 296
+      call $push
+    end
+      ;; 0x170 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x174 CALL 0xe0
+      i32.const 380
+      call $puship
+      i32.const 224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x17c JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x180 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x188 LEAVE
+      call $leave
+    end
+      ;; 0x18c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1c8 NOP
+    end
+      ;; 0x1cc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x1d4 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x1d8 PUSH 0x2b
+      i32.const 43
+      call $push
+    end
+      ;; 0x1e0 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x1e4 PUSH 0x2
+      i32.const 2
+      call $push
+    end
+      ;; 0x1ec OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x1f0 PUSH 0x2b
+      i32.const 43
+      call $push
+    end
+      ;; 0x1f8 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x1fc PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0x204 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x208 PUSH 0x2b
+      i32.const 43
+      call $push
+    end
+      ;; 0x210 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x214 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x21c OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x220 PUSH 0x2b
+      i32.const 43
+      call $push
+    end
+      ;; 0x228 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x22c PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x234 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x238 PUSH 0x3d
+      i32.const 61
+      call $push
+    end
+      ;; 0x240 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x244 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x24c CALL 0x58
+      i32.const 596
+      call $puship
+      i32.const 88
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x254 CALL 0xf4
+      i32.const 604
+      call $puship
+      i32.const 244
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x25c PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0x264 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x26c PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x274 CALL 0xc4
+      i32.const 636
+      call $puship
+      i32.const 196
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x27c CALL 0xc4
+      i32.const 644
+      call $puship
+      i32.const 196
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x284 CALL 0xc4
+      i32.const 652
+      call $puship
+      i32.const 196
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x28c CALL 0xc4
+      i32.const 660
+      call $puship
+      i32.const 196
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x294 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x298 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x2a0 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x2a4 PUSH 0x6
+      i32.const 6
+      call $push
+    end
+      ;; 0x2ac OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x2b0 PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x2b8 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x2bc PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x2c4 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x2c8 PUSH 0x3d
+      i32.const 61
+      call $push
+    end
+      ;; 0x2d0 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x2d4 PUSH 0x6
+      i32.const 6
+      call $push
+    end
+      ;; 0x2dc PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x2e4 CALL 0xd4
+      i32.const 748
+      call $puship
+      i32.const 212
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x2ec OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x2f0 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x2f8 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x2fc PUSH 0x304
+      i32.const 772
+      call $push
+    end
+      ;; 0x304 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 772
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
@@ -0,0 +1,439 @@
+;; Generated by smg from core.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c8\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d4\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $i1d4
+    block $i1cc
+    block $i1c8
+    block $i8
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $default $i8 $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $default $i1c8 $i1cc $default $i1d4 $default
+    end
+      ;; 0x0 PUSH 0x1c8
+      i32.const 456
+      call $push
+    end
+      ;; 0x8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 8
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1c8 NOP
+    end
+      ;; 0x1cc PUSH 0x1d4
+      i32.const 468
+      call $push
+    end
+      ;; 0x1d4 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 468
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
@@ -0,0 +1,803 @@
+;; Generated by smg from fact.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ec\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\90\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\58\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\68\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\70\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $i164
+    block $i160
+    block $i158
+    block $i154
+    block $i150
+    block $i148
+    block $i140
+    block $i13c
+    block $i138
+    block $i130
+    block $i128
+    block $i120
+    block $i11c
+    block $i114
+    block $i10c
+    block $i104
+    block $ifc
+    block $if8
+    block $if4
+    block $iec
+    block $ie8
+    block $ie0
+    block $id8
+    block $id4
+    block $id0
+    block $ic8
+    block $ic0
+    block $ib8
+    block $ib4
+    block $iac
+    block $ia4
+    block $ia0
+    block $i98
+    block $i90
+    block $i88
+    block $i80
+    block $i78
+    block $i70
+    block $i68
+    block $i64
+    block $i60
+    block $i58
+    block $i54
+    block $i50
+    block $i48
+    block $i40
+    block $i38
+    block $i30
+    block $i28
+    block $i20
+    block $i18
+    block $i10
+    block $i8
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $default $i8 $default $i10 $default $i18 $default $i20 $default $i28 $default $i30 $default $i38 $default $i40 $default $i48 $default $i50 $i54 $i58 $default $i60 $i64 $i68 $default $i70 $default $i78 $default $i80 $default $i88 $default $i90 $default $i98 $default $ia0 $ia4 $default $iac $default $ib4 $ib8 $default $ic0 $default $ic8 $default $id0 $id4 $id8 $default $ie0 $default $ie8 $iec $default $if4 $if8 $ifc $default $i104 $default $i10c $default $i114 $default $i11c $i120 $default $i128 $default $i130 $default $i138 $i13c $i140 $default $i148 $default $i150 $i154 $i158 $default $i160 $i164 $default $default $default $default
+    end
+      ;; 0x0 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x8 CALL 0xfc
+      i32.const 16
+      call $puship
+      i32.const 252
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10 CALL 0x54
+      i32.const 24
+      call $puship
+      i32.const 84
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x18 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x20 CALL 0xfc
+      i32.const 40
+      call $puship
+      i32.const 252
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x28 CALL 0x54
+      i32.const 48
+      call $puship
+      i32.const 84
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x30 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x38 CALL 0xfc
+      i32.const 64
+      call $puship
+      i32.const 252
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x40 CALL 0x54
+      i32.const 72
+      call $puship
+      i32.const 84
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x48 PUSH 0x50
+      i32.const 80
+      call $push
+    end
+      ;; 0x50 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 80
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x54 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x58 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x60 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x64 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x68 ENTER 0x3
+      i32.const 3
+      call $enter
+    end
+      ;; 0x70 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x78 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x80 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x88 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x90 PUSH 0xec
+      i32.const 236
+      call $push
+    end
+      ;; 0x98 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa0 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xa4 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xac LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xb8 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xc0 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xc8 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xd0 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xd4 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0xd8 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xe0 PUSH 0x90
+      i32.const 144
+      call $push
+    end
+      ;; 0xe8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 232
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xec LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xf4 LEAVE
+      call $leave
+    end
+      ;; 0xf8 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xfc ENTER 0x1
+      i32.const 1
+      call $enter
+    end
+      ;; 0x104 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x10c PUSH 0x158
+      i32.const 344
+      call $push
+    end
+      ;; 0x114 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x11c JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x120 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x128 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x130 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x138 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x13c SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x140 CALL 0xfc
+      i32.const 328
+      call $puship
+      i32.const 252
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x148 CALL 0x68
+      i32.const 336
+      call $puship
+      i32.const 104
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x150 LEAVE
+      call $leave
+    end
+      ;; 0x154 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x158 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x160 LEAVE
+      call $leave
+    end
+      ;; 0x164 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
@@ -0,0 +1,642 @@
+;; Generated by smg from fib-loop.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c8\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $ic8
+    block $ic0
+    block $ibc
+    block $ib8
+    block $ib4
+    block $ib0
+    block $ia8
+    block $ia0
+    block $i9c
+    block $i94
+    block $i8c
+    block $i88
+    block $i80
+    block $i7c
+    block $i78
+    block $i74
+    block $i70
+    block $i6c
+    block $i68
+    block $i64
+    block $i5c
+    block $i54
+    block $i4c
+    block $i44
+    block $i3c
+    block $i34
+    block $i2c
+    block $i24
+    block $i1c
+    block $i18
+    block $i10
+    block $ic
+    block $i8
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $default $i8 $ic $i10 $default $i18 $i1c $default $i24 $default $i2c $default $i34 $default $i3c $default $i44 $default $i4c $default $i54 $default $i5c $default $i64 $i68 $i6c $i70 $i74 $i78 $i7c $i80 $default $i88 $i8c $default $i94 $default $i9c $ia0 $default $ia8 $default $ib0 $ib4 $ib8 $ibc $ic0 $default $ic8 $default
+    end
+      ;; 0x0 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x8 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xc OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x10 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x18 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x1c PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x24 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x2c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x34 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0x3c STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x44 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x4c PUSH 0xb4
+      i32.const 180
+      call $push
+    end
+      ;; 0x54 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x5c LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x64 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x68 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x6c DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x70 ROL3
+      call $pop
+      local.set $c
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $c
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x74 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x78 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x7c OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x80 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x88 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x8c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x94 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x9c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xa0 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xa8 PUSH 0x4c
+      i32.const 76
+      call $push
+    end
+      ;; 0xb0 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 176
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xb4 LEAVE
+      call $leave
+    end
+      ;; 0xb8 DROP
+      call $pop
+      drop
+    end
+      ;; 0xbc DROP
+      call $pop
+      drop
+    end
+      ;; 0xc0 PUSH 0xc8
+      i32.const 200
+      call $push
+    end
+      ;; 0xc8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 200
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
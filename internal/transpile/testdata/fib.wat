@@ -0,0 +1,793 @@
+;; Generated by smg from fib.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\bc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\94\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\60\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\dc\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $i110
+    block $i108
+    block $i100
+    block $if8
+    block $if0
+    block $ie8
+    block $ie4
+    block $idc
+    block $id4
+    block $icc
+    block $ic4
+    block $ibc
+    block $ib8
+    block $ib4
+    block $ib0
+    block $iac
+    block $ia8
+    block $ia4
+    block $ia0
+    block $i9c
+    block $i98
+    block $i94
+    block $i90
+    block $i8c
+    block $i84
+    block $i80
+    block $i7c
+    block $i78
+    block $i70
+    block $i68
+    block $i60
+    block $i5c
+    block $i58
+    block $i50
+    block $i4c
+    block $i48
+    block $i44
+    block $i40
+    block $i38
+    block $i34
+    block $i30
+    block $i28
+    block $i24
+    block $i20
+    block $i18
+    block $i10
+    block $ic
+    block $i8
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $default $i8 $ic $i10 $default $i18 $default $i20 $i24 $i28 $default $i30 $i34 $i38 $default $i40 $i44 $i48 $i4c $i50 $default $i58 $i5c $i60 $default $i68 $default $i70 $default $i78 $i7c $i80 $i84 $default $i8c $i90 $i94 $i98 $i9c $ia0 $ia4 $ia8 $iac $ib0 $ib4 $ib8 $ibc $default $ic4 $default $icc $default $id4 $default $idc $default $ie4 $ie8 $default $if0 $default $if8 $default $i100 $default $i108 $default $i110 $default
+    end
+      ;; 0x0 PUSH 0xbc
+      i32.const 188
+      call $push
+    end
+      ;; 0x8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 8
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc NOP
+    end
+      ;; 0x10 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x18 PUSH 0xc
+      i32.const 12
+      call $push
+    end
+      ;; 0x20 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0x24 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x28 PUSH 0xc
+      i32.const 12
+      call $push
+    end
+      ;; 0x30 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x34 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x38 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x40 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x44 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x48 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4c DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x50 PUSH 0xc
+      i32.const 12
+      call $push
+    end
+      ;; 0x58 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0x5c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x60 CALL 0x28
+      i32.const 104
+      call $puship
+      i32.const 40
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x68 CALL 0x38
+      i32.const 112
+      call $puship
+      i32.const 56
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x70 CALL 0x4c
+      i32.const 120
+      call $puship
+      i32.const 76
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x78 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x7c DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x80 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x84 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x8c OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x90 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x94 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x98 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x9c ROL3
+      call $pop
+      local.set $c
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $c
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xa0 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xa4 ROL3
+      call $pop
+      local.set $c
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $c
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xa8 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xac POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xb0 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xb4 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xb8 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbc CALL 0x10
+      i32.const 196
+      call $puship
+      i32.const 16
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc4 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xcc CALL 0x7c
+      i32.const 212
+      call $puship
+      i32.const 124
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xdc CALL 0x94
+      i32.const 228
+      call $puship
+      i32.const 148
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xe8 CALL 0x7c
+      i32.const 240
+      call $puship
+      i32.const 124
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf0 CALL 0x60
+      i32.const 248
+      call $puship
+      i32.const 96
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf8 PUSH 0xdc
+      i32.const 220
+      call $push
+    end
+      ;; 0x100 CALL 0xb0
+      i32.const 264
+      call $puship
+      i32.const 176
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x108 PUSH 0x110
+      i32.const 272
+      call $push
+    end
+      ;; 0x110 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 272
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
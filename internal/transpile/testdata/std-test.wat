@@ -0,0 +1,6985 @@
+;; Generated by smg from std-test.src
+(module
+  (import "env" "out" (func $out (param i32)))
+  (import "env" "in" (func $in (result i32)))
+  (import "env" "fault" (func $fault (param i32)))
+  (memory (export "memory") 76)
+  (data (i32.const 0) "\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\10\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\a0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\88\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f0\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b8\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f6\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\24\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2c\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\3c\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\88\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\18\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ac\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\40\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fc\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\44\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\60\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\60\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\12\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\60\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\3c\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b0\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\22\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\7f\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\7f\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\48\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\7f\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c0\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e4\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\58\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fd\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\68\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fd\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fd\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\c4\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\29\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\30\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\40\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\50\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fa\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\07\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d6\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fa\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f9\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d1\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f7\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d1\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fe\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d1\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fe\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f7\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f7\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\fb\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ff\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\8c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f8\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d8\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\7c\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\19\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f6\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\44\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\f8\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\80\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\28\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\44\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\d8\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\24\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\85\ff\ff\ff\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2c\15\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\13\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\4c\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\0a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\05\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0e\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\08\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\ec\ff\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\70\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\54\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\5c\18\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\b4\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\98\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\70\0c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\e0\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\60\18\01\00\00\00\00\00\00\00\00\00\00\00\00\00\09\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\11\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\a4\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\06\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\1c\17\00\00\00\00\00\00\00\00\00\00\00\00\00\00\0b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\63\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\68\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\63\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\66\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\61\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\69\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\3a\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\67\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\78\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\70\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\63\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\74\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\63\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\68\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\63\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6b\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\73\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\20\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\70\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\61\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\73\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\73\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\64\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\68\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\65\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6c\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\6f\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\32\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\31\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\37\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\33\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\36\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\38\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\32\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\34\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\78\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\32\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\2d\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\01\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\02\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\03\00\00\00\00\00\00\00\00\00\00\00\00\00\00\00\04\00\00\00")
+
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4096000
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=4096000)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=4358144
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=4358144)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const 1024000
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=4964352
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=4964352
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const 4096
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=4882432
+    local.get $h
+    global.get $sp
+    i32.store offset=4882436
+    local.get $h
+    global.get $ipsp
+    i32.store offset=4882440
+    local.get $h
+    global.get $fsp
+    i32.store offset=4882444
+    local.get $h
+    global.get $fp
+    i32.store offset=4882448
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -7
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const -6
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const 20
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=4882436
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882436
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=4882440
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882440
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=4882444
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=4882444
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=4882448
+    global.set $fp
+    local.get $h
+    i32.load offset=4882432
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=4882432)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const 65536
+    i32.ge_u
+    if
+      i32.const -9
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=4620288
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=4620288
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=4620288
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+
+  (func $run (export "run") (result i32)
+    (local $pc i32)
+    (local $a i32)
+    (local $b i32)
+    (local $c i32)
+    i32.const 0
+    local.set $pc
+    loop $dispatch
+    block $default
+    block $i1858
+    block $i1854
+    block $i1850
+    block $i184c
+    block $i1848
+    block $i1844
+    block $i1840
+    block $i183c
+    block $i1838
+    block $i1834
+    block $i1830
+    block $i182c
+    block $i1828
+    block $i1824
+    block $i1820
+    block $i181c
+    block $i1818
+    block $i1814
+    block $i1810
+    block $i180c
+    block $i1808
+    block $i1804
+    block $i1800
+    block $i17fc
+    block $i17f8
+    block $i17f4
+    block $i17f0
+    block $i17ec
+    block $i17e8
+    block $i17e4
+    block $i17e0
+    block $i17dc
+    block $i17d8
+    block $i17d4
+    block $i17d0
+    block $i17cc
+    block $i17c8
+    block $i17c4
+    block $i17c0
+    block $i17bc
+    block $i17b8
+    block $i17b4
+    block $i17b0
+    block $i17ac
+    block $i17a8
+    block $i17a4
+    block $i17a0
+    block $i179c
+    block $i1798
+    block $i1794
+    block $i1790
+    block $i178c
+    block $i1788
+    block $i1784
+    block $i1780
+    block $i177c
+    block $i1778
+    block $i1774
+    block $i1770
+    block $i176c
+    block $i1768
+    block $i1764
+    block $i1760
+    block $i175c
+    block $i1758
+    block $i1754
+    block $i1750
+    block $i174c
+    block $i1748
+    block $i1744
+    block $i1740
+    block $i173c
+    block $i1738
+    block $i1734
+    block $i1730
+    block $i172c
+    block $i1728
+    block $i1724
+    block $i1720
+    block $i171c
+    block $i1714
+    block $i170c
+    block $i1704
+    block $i1700
+    block $i16fc
+    block $i16f4
+    block $i16ec
+    block $i16e4
+    block $i16dc
+    block $i16d4
+    block $i16cc
+    block $i16c4
+    block $i16bc
+    block $i16b4
+    block $i16ac
+    block $i16a4
+    block $i169c
+    block $i1694
+    block $i168c
+    block $i1684
+    block $i167c
+    block $i1674
+    block $i166c
+    block $i1664
+    block $i165c
+    block $i1654
+    block $i164c
+    block $i1644
+    block $i163c
+    block $i1634
+    block $i1630
+    block $i1628
+    block $i1620
+    block $i1618
+    block $i1610
+    block $i160c
+    block $i1604
+    block $i15fc
+    block $i15f4
+    block $i15ec
+    block $i15e4
+    block $i15dc
+    block $i15d4
+    block $i15d0
+    block $i15cc
+    block $i15c4
+    block $i15bc
+    block $i15b4
+    block $i15ac
+    block $i15a8
+    block $i15a4
+    block $i159c
+    block $i1594
+    block $i158c
+    block $i1584
+    block $i157c
+    block $i1574
+    block $i156c
+    block $i1564
+    block $i1560
+    block $i155c
+    block $i1554
+    block $i154c
+    block $i1544
+    block $i153c
+    block $i1534
+    block $i152c
+    block $i1528
+    block $i1524
+    block $i1520
+    block $i1518
+    block $i1510
+    block $i1508
+    block $i1500
+    block $i14f8
+    block $i14f4
+    block $i14f0
+    block $i14e8
+    block $i14e4
+    block $i14dc
+    block $i14d8
+    block $i14d0
+    block $i14c8
+    block $i14c0
+    block $i14b8
+    block $i14b4
+    block $i14ac
+    block $i14a4
+    block $i149c
+    block $i1494
+    block $i148c
+    block $i1484
+    block $i147c
+    block $i1474
+    block $i146c
+    block $i1464
+    block $i145c
+    block $i1454
+    block $i144c
+    block $i1444
+    block $i143c
+    block $i1434
+    block $i142c
+    block $i1424
+    block $i141c
+    block $i1418
+    block $i1410
+    block $i1408
+    block $i1400
+    block $i13f8
+    block $i13f0
+    block $i13e8
+    block $i13e0
+    block $i13d8
+    block $i13d0
+    block $i13c8
+    block $i13c0
+    block $i13bc
+    block $i13b4
+    block $i13ac
+    block $i13a4
+    block $i139c
+    block $i1394
+    block $i138c
+    block $i1384
+    block $i137c
+    block $i1374
+    block $i136c
+    block $i1364
+    block $i135c
+    block $i1354
+    block $i134c
+    block $i1344
+    block $i133c
+    block $i1334
+    block $i132c
+    block $i1324
+    block $i131c
+    block $i1314
+    block $i130c
+    block $i1304
+    block $i12fc
+    block $i12f4
+    block $i12ec
+    block $i12e4
+    block $i12dc
+    block $i12d4
+    block $i12cc
+    block $i12c4
+    block $i12bc
+    block $i12b4
+    block $i12ac
+    block $i12a4
+    block $i129c
+    block $i1294
+    block $i128c
+    block $i1284
+    block $i127c
+    block $i1274
+    block $i126c
+    block $i1264
+    block $i125c
+    block $i1254
+    block $i124c
+    block $i1244
+    block $i123c
+    block $i1234
+    block $i122c
+    block $i1224
+    block $i121c
+    block $i1214
+    block $i120c
+    block $i1204
+    block $i11fc
+    block $i11f4
+    block $i11ec
+    block $i11e4
+    block $i11dc
+    block $i11d4
+    block $i11cc
+    block $i11c4
+    block $i11bc
+    block $i11b4
+    block $i11ac
+    block $i11a4
+    block $i119c
+    block $i1194
+    block $i118c
+    block $i1184
+    block $i117c
+    block $i1174
+    block $i116c
+    block $i1164
+    block $i115c
+    block $i1154
+    block $i114c
+    block $i1144
+    block $i113c
+    block $i1134
+    block $i112c
+    block $i1124
+    block $i111c
+    block $i1114
+    block $i110c
+    block $i1104
+    block $i10fc
+    block $i10f4
+    block $i10ec
+    block $i10e4
+    block $i10dc
+    block $i10d4
+    block $i10cc
+    block $i10c4
+    block $i10bc
+    block $i10b4
+    block $i10ac
+    block $i10a4
+    block $i109c
+    block $i1094
+    block $i108c
+    block $i1084
+    block $i107c
+    block $i1074
+    block $i106c
+    block $i1064
+    block $i105c
+    block $i1054
+    block $i104c
+    block $i1044
+    block $i103c
+    block $i1034
+    block $i102c
+    block $i1024
+    block $i101c
+    block $i1014
+    block $i100c
+    block $i1004
+    block $iffc
+    block $iff4
+    block $ifec
+    block $ife4
+    block $ifdc
+    block $ifd4
+    block $ifcc
+    block $ifc4
+    block $ifbc
+    block $ifb4
+    block $ifac
+    block $ifa4
+    block $if9c
+    block $if94
+    block $if8c
+    block $if84
+    block $if7c
+    block $if74
+    block $if6c
+    block $if64
+    block $if5c
+    block $if54
+    block $if4c
+    block $if44
+    block $if3c
+    block $if34
+    block $if2c
+    block $if24
+    block $if1c
+    block $if14
+    block $if0c
+    block $if04
+    block $iefc
+    block $ief4
+    block $ieec
+    block $iee4
+    block $iedc
+    block $ied4
+    block $iecc
+    block $iec4
+    block $iebc
+    block $ieb4
+    block $ieac
+    block $iea4
+    block $ie9c
+    block $ie94
+    block $ie8c
+    block $ie84
+    block $ie7c
+    block $ie74
+    block $ie6c
+    block $ie64
+    block $ie5c
+    block $ie54
+    block $ie4c
+    block $ie44
+    block $ie3c
+    block $ie34
+    block $ie2c
+    block $ie24
+    block $ie1c
+    block $ie14
+    block $ie0c
+    block $ie04
+    block $idfc
+    block $idf4
+    block $idec
+    block $ide4
+    block $iddc
+    block $idd4
+    block $idcc
+    block $idc4
+    block $idbc
+    block $idb4
+    block $idac
+    block $ida4
+    block $id9c
+    block $id94
+    block $id8c
+    block $id84
+    block $id7c
+    block $id74
+    block $id6c
+    block $id68
+    block $id64
+    block $id60
+    block $id58
+    block $id50
+    block $id4c
+    block $id44
+    block $id3c
+    block $id34
+    block $id30
+    block $id28
+    block $id20
+    block $id18
+    block $id14
+    block $id10
+    block $id08
+    block $id00
+    block $icf8
+    block $icf4
+    block $icec
+    block $ice4
+    block $icdc
+    block $icd4
+    block $icd0
+    block $icc8
+    block $icc4
+    block $icbc
+    block $icb8
+    block $icb0
+    block $ica8
+    block $ica0
+    block $ic98
+    block $ic94
+    block $ic8c
+    block $ic88
+    block $ic84
+    block $ic80
+    block $ic78
+    block $ic70
+    block $ic6c
+    block $ic68
+    block $ic60
+    block $ic5c
+    block $ic54
+    block $ic50
+    block $ic4c
+    block $ic44
+    block $ic40
+    block $ic3c
+    block $ic38
+    block $ic30
+    block $ic2c
+    block $ic24
+    block $ic20
+    block $ic18
+    block $ic14
+    block $ic10
+    block $ic08
+    block $ic00
+    block $ibfc
+    block $ibf4
+    block $ibec
+    block $ibe4
+    block $ibdc
+    block $ibd4
+    block $ibd0
+    block $ibc8
+    block $ibc4
+    block $ibbc
+    block $ibb4
+    block $ibb0
+    block $ibac
+    block $iba8
+    block $iba0
+    block $ib98
+    block $ib94
+    block $ib8c
+    block $ib84
+    block $ib7c
+    block $ib78
+    block $ib70
+    block $ib68
+    block $ib64
+    block $ib5c
+    block $ib54
+    block $ib50
+    block $ib48
+    block $ib40
+    block $ib38
+    block $ib30
+    block $ib28
+    block $ib20
+    block $ib1c
+    block $ib18
+    block $ib14
+    block $ib0c
+    block $ib04
+    block $ib00
+    block $iaf8
+    block $iaf0
+    block $iae8
+    block $iae4
+    block $iadc
+    block $iad4
+    block $iacc
+    block $iac8
+    block $iac0
+    block $iab8
+    block $iab4
+    block $iaac
+    block $iaa8
+    block $iaa0
+    block $ia9c
+    block $ia94
+    block $ia8c
+    block $ia84
+    block $ia7c
+    block $ia74
+    block $ia6c
+    block $ia68
+    block $ia60
+    block $ia5c
+    block $ia58
+    block $ia54
+    block $ia50
+    block $ia4c
+    block $ia44
+    block $ia3c
+    block $ia34
+    block $ia30
+    block $ia28
+    block $ia24
+    block $ia1c
+    block $ia18
+    block $ia10
+    block $ia08
+    block $ia04
+    block $i9fc
+    block $i9f4
+    block $i9f0
+    block $i9e8
+    block $i9e4
+    block $i9dc
+    block $i9d4
+    block $i9cc
+    block $i9c8
+    block $i9c0
+    block $i9bc
+    block $i9b8
+    block $i9b4
+    block $i9b0
+    block $i9ac
+    block $i9a8
+    block $i9a4
+    block $i99c
+    block $i994
+    block $i990
+    block $i988
+    block $i980
+    block $i978
+    block $i970
+    block $i968
+    block $i964
+    block $i960
+    block $i958
+    block $i950
+    block $i948
+    block $i944
+    block $i93c
+    block $i934
+    block $i930
+    block $i928
+    block $i920
+    block $i918
+    block $i910
+    block $i90c
+    block $i904
+    block $i8fc
+    block $i8f4
+    block $i8ec
+    block $i8e8
+    block $i8e0
+    block $i8d8
+    block $i8d0
+    block $i8c8
+    block $i8c4
+    block $i8bc
+    block $i8b4
+    block $i8ac
+    block $i8a4
+    block $i8a0
+    block $i898
+    block $i890
+    block $i888
+    block $i880
+    block $i878
+    block $i874
+    block $i86c
+    block $i868
+    block $i864
+    block $i860
+    block $i85c
+    block $i858
+    block $i850
+    block $i84c
+    block $i848
+    block $i840
+    block $i838
+    block $i830
+    block $i82c
+    block $i824
+    block $i820
+    block $i818
+    block $i814
+    block $i80c
+    block $i804
+    block $i800
+    block $i7f8
+    block $i7f0
+    block $i7ec
+    block $i7e4
+    block $i7dc
+    block $i7d4
+    block $i7d0
+    block $i7c8
+    block $i7c0
+    block $i7b8
+    block $i7b4
+    block $i7ac
+    block $i7a8
+    block $i7a4
+    block $i7a0
+    block $i79c
+    block $i798
+    block $i794
+    block $i790
+    block $i788
+    block $i780
+    block $i77c
+    block $i774
+    block $i76c
+    block $i764
+    block $i75c
+    block $i754
+    block $i750
+    block $i74c
+    block $i744
+    block $i740
+    block $i738
+    block $i730
+    block $i72c
+    block $i724
+    block $i71c
+    block $i714
+    block $i70c
+    block $i708
+    block $i704
+    block $i6fc
+    block $i6f8
+    block $i6f0
+    block $i6e8
+    block $i6e0
+    block $i6d8
+    block $i6d4
+    block $i6cc
+    block $i6c8
+    block $i6c4
+    block $i6c0
+    block $i6b8
+    block $i6b4
+    block $i6ac
+    block $i6a4
+    block $i6a0
+    block $i698
+    block $i690
+    block $i688
+    block $i684
+    block $i67c
+    block $i674
+    block $i670
+    block $i66c
+    block $i664
+    block $i65c
+    block $i654
+    block $i64c
+    block $i648
+    block $i640
+    block $i638
+    block $i634
+    block $i630
+    block $i62c
+    block $i628
+    block $i620
+    block $i618
+    block $i614
+    block $i60c
+    block $i604
+    block $i600
+    block $i5fc
+    block $i5f8
+    block $i5f0
+    block $i5ec
+    block $i5e8
+    block $i5e0
+    block $i5d8
+    block $i5d0
+    block $i5bc
+    block $i5b8
+    block $i5b0
+    block $i5ac
+    block $i5a4
+    block $i5a0
+    block $i59c
+    block $i598
+    block $i594
+    block $i58c
+    block $i588
+    block $i584
+    block $i57c
+    block $i578
+    block $i574
+    block $i56c
+    block $i564
+    block $i55c
+    block $i554
+    block $i54c
+    block $i548
+    block $i540
+    block $i53c
+    block $i534
+    block $i52c
+    block $i524
+    block $i51c
+    block $i514
+    block $i50c
+    block $i508
+    block $i500
+    block $i4f8
+    block $i4f0
+    block $i4e8
+    block $i4e0
+    block $i4d8
+    block $i4d0
+    block $i4c8
+    block $i4c0
+    block $i4b8
+    block $i4b4
+    block $i4ac
+    block $i4a8
+    block $i4a0
+    block $i498
+    block $i490
+    block $i488
+    block $i480
+    block $i47c
+    block $i478
+    block $i470
+    block $i468
+    block $i464
+    block $i45c
+    block $i454
+    block $i44c
+    block $i448
+    block $i440
+    block $i438
+    block $i430
+    block $i42c
+    block $i424
+    block $i41c
+    block $i414
+    block $i410
+    block $i408
+    block $i400
+    block $i3fc
+    block $i3f4
+    block $i3ec
+    block $i3e4
+    block $i3dc
+    block $i3d4
+    block $i3d0
+    block $i3c8
+    block $i3c0
+    block $i3b8
+    block $i3b4
+    block $i3ac
+    block $i3a4
+    block $i39c
+    block $i398
+    block $i390
+    block $i388
+    block $i384
+    block $i37c
+    block $i374
+    block $i36c
+    block $i364
+    block $i35c
+    block $i354
+    block $i34c
+    block $i348
+    block $i344
+    block $i33c
+    block $i338
+    block $i330
+    block $i328
+    block $i320
+    block $i31c
+    block $i314
+    block $i30c
+    block $i304
+    block $i300
+    block $i2f8
+    block $i2f0
+    block $i2e8
+    block $i2e4
+    block $i2dc
+    block $i2d4
+    block $i2d0
+    block $i2cc
+    block $i2c4
+    block $i2bc
+    block $i2b4
+    block $i2ac
+    block $i2a4
+    block $i29c
+    block $i294
+    block $i28c
+    block $i288
+    block $i280
+    block $i27c
+    block $i278
+    block $i270
+    block $i268
+    block $i264
+    block $i260
+    block $i25c
+    block $i254
+    block $i250
+    block $i24c
+    block $i248
+    block $i240
+    block $i23c
+    block $i238
+    block $i230
+    block $i22c
+    block $i224
+    block $i220
+    block $i21c
+    block $i218
+    block $i210
+    block $i20c
+    block $i208
+    block $i200
+    block $i1fc
+    block $i1f4
+    block $i1ec
+    block $i1e4
+    block $i1dc
+    block $i1d4
+    block $i1cc
+    block $i1c4
+    block $i1c0
+    block $i1bc
+    block $i1b4
+    block $i1b0
+    block $i1ac
+    block $i1a4
+    block $i1a0
+    block $i198
+    block $i190
+    block $i188
+    block $i180
+    block $i178
+    block $i170
+    block $i168
+    block $i164
+    block $i15c
+    block $i158
+    block $i154
+    block $i150
+    block $i148
+    block $i144
+    block $i140
+    block $i13c
+    block $i134
+    block $i12c
+    block $i128
+    block $i124
+    block $i11c
+    block $i114
+    block $i110
+    block $i108
+    block $i104
+    block $ifc
+    block $if4
+    block $iec
+    block $ie4
+    block $ie0
+    block $idc
+    block $id4
+    block $id0
+    block $icc
+    block $ic4
+    block $ic0
+    block $ibc
+    block $ib4
+    block $ib0
+    block $iac
+    block $ia8
+    block $ia0
+    block $i9c
+    block $i98
+    block $i94
+    block $i8c
+    block $i84
+    block $i80
+    block $i78
+    block $i74
+    block $i70
+    block $i68
+    block $i60
+    block $i58
+    block $i50
+    block $i48
+    block $i44
+    block $i40
+    block $i3c
+    block $i38
+    block $i30
+    block $i2c
+    block $i28
+    block $i24
+    block $i20
+    block $i1c
+    block $i18
+    block $i14
+    block $i10
+    block $ic
+    block $i8
+    block $i4
+    block $i0
+      local.get $pc
+      call $index
+      br_table $i0 $i4 $i8 $ic $i10 $i14 $i18 $i1c $i20 $i24 $i28 $i2c $i30 $default $i38 $i3c $i40 $i44 $i48 $default $i50 $default $i58 $default $i60 $default $i68 $default $i70 $i74 $i78 $default $i80 $i84 $default $i8c $default $i94 $i98 $i9c $ia0 $default $ia8 $iac $ib0 $ib4 $default $ibc $ic0 $ic4 $default $icc $id0 $id4 $default $idc $ie0 $ie4 $default $iec $default $if4 $default $ifc $default $i104 $i108 $default $i110 $i114 $default $i11c $default $i124 $i128 $i12c $default $i134 $default $i13c $i140 $i144 $i148 $default $i150 $i154 $i158 $i15c $default $i164 $i168 $default $i170 $default $i178 $default $i180 $default $i188 $default $i190 $default $i198 $default $i1a0 $i1a4 $default $i1ac $i1b0 $i1b4 $default $i1bc $i1c0 $i1c4 $default $i1cc $default $i1d4 $default $i1dc $default $i1e4 $default $i1ec $default $i1f4 $default $i1fc $i200 $default $i208 $i20c $i210 $default $i218 $i21c $i220 $i224 $default $i22c $i230 $default $i238 $i23c $i240 $default $i248 $i24c $i250 $i254 $default $i25c $i260 $i264 $i268 $default $i270 $default $i278 $i27c $i280 $default $i288 $i28c $default $i294 $default $i29c $default $i2a4 $default $i2ac $default $i2b4 $default $i2bc $default $i2c4 $default $i2cc $i2d0 $i2d4 $default $i2dc $default $i2e4 $i2e8 $default $i2f0 $default $i2f8 $default $i300 $i304 $default $i30c $default $i314 $default $i31c $i320 $default $i328 $default $i330 $default $i338 $i33c $default $i344 $i348 $i34c $default $i354 $default $i35c $default $i364 $default $i36c $default $i374 $default $i37c $default $i384 $i388 $default $i390 $default $i398 $i39c $default $i3a4 $default $i3ac $default $i3b4 $i3b8 $default $i3c0 $default $i3c8 $default $i3d0 $i3d4 $default $i3dc $default $i3e4 $default $i3ec $default $i3f4 $default $i3fc $i400 $default $i408 $default $i410 $i414 $default $i41c $default $i424 $default $i42c $i430 $default $i438 $default $i440 $default $i448 $i44c $default $i454 $default $i45c $default $i464 $i468 $default $i470 $default $i478 $i47c $i480 $default $i488 $default $i490 $default $i498 $default $i4a0 $default $i4a8 $i4ac $default $i4b4 $i4b8 $default $i4c0 $default $i4c8 $default $i4d0 $default $i4d8 $default $i4e0 $default $i4e8 $default $i4f0 $default $i4f8 $default $i500 $default $i508 $i50c $default $i514 $default $i51c $default $i524 $default $i52c $default $i534 $default $i53c $i540 $default $i548 $i54c $default $i554 $default $i55c $default $i564 $default $i56c $default $i574 $i578 $i57c $default $i584 $i588 $i58c $default $i594 $i598 $i59c $i5a0 $i5a4 $default $i5ac $i5b0 $default $i5b8 $i5bc $default $default $default $default $i5d0 $default $i5d8 $default $i5e0 $default $i5e8 $i5ec $i5f0 $default $i5f8 $i5fc $i600 $i604 $default $i60c $default $i614 $i618 $default $i620 $default $i628 $i62c $i630 $i634 $i638 $default $i640 $default $i648 $i64c $default $i654 $default $i65c $default $i664 $default $i66c $i670 $i674 $default $i67c $default $i684 $i688 $default $i690 $default $i698 $default $i6a0 $i6a4 $default $i6ac $default $i6b4 $i6b8 $default $i6c0 $i6c4 $i6c8 $i6cc $default $i6d4 $i6d8 $default $i6e0 $default $i6e8 $default $i6f0 $default $i6f8 $i6fc $default $i704 $i708 $i70c $default $i714 $default $i71c $default $i724 $default $i72c $i730 $default $i738 $default $i740 $i744 $default $i74c $i750 $i754 $default $i75c $default $i764 $default $i76c $default $i774 $default $i77c $i780 $default $i788 $default $i790 $i794 $i798 $i79c $i7a0 $i7a4 $i7a8 $i7ac $default $i7b4 $i7b8 $default $i7c0 $default $i7c8 $default $i7d0 $i7d4 $default $i7dc $default $i7e4 $default $i7ec $i7f0 $default $i7f8 $default $i800 $i804 $default $i80c $default $i814 $i818 $default $i820 $i824 $default $i82c $i830 $default $i838 $default $i840 $default $i848 $i84c $i850 $default $i858 $i85c $i860 $i864 $i868 $i86c $default $i874 $i878 $default $i880 $default $i888 $default $i890 $default $i898 $default $i8a0 $i8a4 $default $i8ac $default $i8b4 $default $i8bc $default $i8c4 $i8c8 $default $i8d0 $default $i8d8 $default $i8e0 $default $i8e8 $i8ec $default $i8f4 $default $i8fc $default $i904 $default $i90c $i910 $default $i918 $default $i920 $default $i928 $default $i930 $i934 $default $i93c $default $i944 $i948 $default $i950 $default $i958 $default $i960 $i964 $i968 $default $i970 $default $i978 $default $i980 $default $i988 $default $i990 $i994 $default $i99c $default $i9a4 $i9a8 $i9ac $i9b0 $i9b4 $i9b8 $i9bc $i9c0 $default $i9c8 $i9cc $default $i9d4 $default $i9dc $default $i9e4 $i9e8 $default $i9f0 $i9f4 $default $i9fc $default $ia04 $ia08 $default $ia10 $default $ia18 $ia1c $default $ia24 $ia28 $default $ia30 $ia34 $default $ia3c $default $ia44 $default $ia4c $ia50 $ia54 $ia58 $ia5c $ia60 $default $ia68 $ia6c $default $ia74 $default $ia7c $default $ia84 $default $ia8c $default $ia94 $default $ia9c $iaa0 $default $iaa8 $iaac $default $iab4 $iab8 $default $iac0 $default $iac8 $iacc $default $iad4 $default $iadc $default $iae4 $iae8 $default $iaf0 $default $iaf8 $default $ib00 $ib04 $default $ib0c $default $ib14 $ib18 $ib1c $ib20 $default $ib28 $default $ib30 $default $ib38 $default $ib40 $default $ib48 $default $ib50 $ib54 $default $ib5c $default $ib64 $ib68 $default $ib70 $default $ib78 $ib7c $default $ib84 $default $ib8c $default $ib94 $ib98 $default $iba0 $default $iba8 $ibac $ibb0 $ibb4 $default $ibbc $default $ibc4 $ibc8 $default $ibd0 $ibd4 $default $ibdc $default $ibe4 $default $ibec $default $ibf4 $default $ibfc $ic00 $default $ic08 $default $ic10 $ic14 $ic18 $default $ic20 $ic24 $default $ic2c $ic30 $default $ic38 $ic3c $ic40 $ic44 $default $ic4c $ic50 $ic54 $default $ic5c $ic60 $default $ic68 $ic6c $ic70 $default $ic78 $default $ic80 $ic84 $ic88 $ic8c $default $ic94 $ic98 $default $ica0 $default $ica8 $default $icb0 $default $icb8 $icbc $default $icc4 $icc8 $default $icd0 $icd4 $default $icdc $default $ice4 $default $icec $default $icf4 $icf8 $default $id00 $default $id08 $default $id10 $id14 $id18 $default $id20 $default $id28 $default $id30 $id34 $default $id3c $default $id44 $default $id4c $id50 $default $id58 $default $id60 $id64 $id68 $id6c $default $id74 $default $id7c $default $id84 $default $id8c $default $id94 $default $id9c $default $ida4 $default $idac $default $idb4 $default $idbc $default $idc4 $default $idcc $default $idd4 $default $iddc $default $ide4 $default $idec $default $idf4 $default $idfc $default $ie04 $default $ie0c $default $ie14 $default $ie1c $default $ie24 $default $ie2c $default $ie34 $default $ie3c $default $ie44 $default $ie4c $default $ie54 $default $ie5c $default $ie64 $default $ie6c $default $ie74 $default $ie7c $default $ie84 $default $ie8c $default $ie94 $default $ie9c $default $iea4 $default $ieac $default $ieb4 $default $iebc $default $iec4 $default $iecc $default $ied4 $default $iedc $default $iee4 $default $ieec $default $ief4 $default $iefc $default $if04 $default $if0c $default $if14 $default $if1c $default $if24 $default $if2c $default $if34 $default $if3c $default $if44 $default $if4c $default $if54 $default $if5c $default $if64 $default $if6c $default $if74 $default $if7c $default $if84 $default $if8c $default $if94 $default $if9c $default $ifa4 $default $ifac $default $ifb4 $default $ifbc $default $ifc4 $default $ifcc $default $ifd4 $default $ifdc $default $ife4 $default $ifec $default $iff4 $default $iffc $default $i1004 $default $i100c $default $i1014 $default $i101c $default $i1024 $default $i102c $default $i1034 $default $i103c $default $i1044 $default $i104c $default $i1054 $default $i105c $default $i1064 $default $i106c $default $i1074 $default $i107c $default $i1084 $default $i108c $default $i1094 $default $i109c $default $i10a4 $default $i10ac $default $i10b4 $default $i10bc $default $i10c4 $default $i10cc $default $i10d4 $default $i10dc $default $i10e4 $default $i10ec $default $i10f4 $default $i10fc $default $i1104 $default $i110c $default $i1114 $default $i111c $default $i1124 $default $i112c $default $i1134 $default $i113c $default $i1144 $default $i114c $default $i1154 $default $i115c $default $i1164 $default $i116c $default $i1174 $default $i117c $default $i1184 $default $i118c $default $i1194 $default $i119c $default $i11a4 $default $i11ac $default $i11b4 $default $i11bc $default $i11c4 $default $i11cc $default $i11d4 $default $i11dc $default $i11e4 $default $i11ec $default $i11f4 $default $i11fc $default $i1204 $default $i120c $default $i1214 $default $i121c $default $i1224 $default $i122c $default $i1234 $default $i123c $default $i1244 $default $i124c $default $i1254 $default $i125c $default $i1264 $default $i126c $default $i1274 $default $i127c $default $i1284 $default $i128c $default $i1294 $default $i129c $default $i12a4 $default $i12ac $default $i12b4 $default $i12bc $default $i12c4 $default $i12cc $default $i12d4 $default $i12dc $default $i12e4 $default $i12ec $default $i12f4 $default $i12fc $default $i1304 $default $i130c $default $i1314 $default $i131c $default $i1324 $default $i132c $default $i1334 $default $i133c $default $i1344 $default $i134c $default $i1354 $default $i135c $default $i1364 $default $i136c $default $i1374 $default $i137c $default $i1384 $default $i138c $default $i1394 $default $i139c $default $i13a4 $default $i13ac $default $i13b4 $default $i13bc $i13c0 $default $i13c8 $default $i13d0 $default $i13d8 $default $i13e0 $default $i13e8 $default $i13f0 $default $i13f8 $default $i1400 $default $i1408 $default $i1410 $default $i1418 $i141c $default $i1424 $default $i142c $default $i1434 $default $i143c $default $i1444 $default $i144c $default $i1454 $default $i145c $default $i1464 $default $i146c $default $i1474 $default $i147c $default $i1484 $default $i148c $default $i1494 $default $i149c $default $i14a4 $default $i14ac $default $i14b4 $i14b8 $default $i14c0 $default $i14c8 $default $i14d0 $default $i14d8 $i14dc $default $i14e4 $i14e8 $default $i14f0 $i14f4 $i14f8 $default $i1500 $default $i1508 $default $i1510 $default $i1518 $default $i1520 $i1524 $i1528 $i152c $default $i1534 $default $i153c $default $i1544 $default $i154c $default $i1554 $default $i155c $i1560 $i1564 $default $i156c $default $i1574 $default $i157c $default $i1584 $default $i158c $default $i1594 $default $i159c $default $i15a4 $i15a8 $i15ac $default $i15b4 $default $i15bc $default $i15c4 $default $i15cc $i15d0 $i15d4 $default $i15dc $default $i15e4 $default $i15ec $default $i15f4 $default $i15fc $default $i1604 $default $i160c $i1610 $default $i1618 $default $i1620 $default $i1628 $default $i1630 $i1634 $default $i163c $default $i1644 $default $i164c $default $i1654 $default $i165c $default $i1664 $default $i166c $default $i1674 $default $i167c $default $i1684 $default $i168c $default $i1694 $default $i169c $default $i16a4 $default $i16ac $default $i16b4 $default $i16bc $default $i16c4 $default $i16cc $default $i16d4 $default $i16dc $default $i16e4 $default $i16ec $default $i16f4 $default $i16fc $i1700 $i1704 $default $i170c $default $i1714 $default $i171c $i1720 $i1724 $i1728 $i172c $i1730 $i1734 $i1738 $i173c $i1740 $i1744 $i1748 $i174c $i1750 $i1754 $i1758 $i175c $i1760 $i1764 $i1768 $i176c $i1770 $i1774 $i1778 $i177c $i1780 $i1784 $i1788 $i178c $i1790 $i1794 $i1798 $i179c $i17a0 $i17a4 $i17a8 $i17ac $i17b0 $i17b4 $i17b8 $i17bc $i17c0 $i17c4 $i17c8 $i17cc $i17d0 $i17d4 $i17d8 $i17dc $i17e0 $i17e4 $i17e8 $i17ec $i17f0 $i17f4 $i17f8 $i17fc $i1800 $i1804 $i1808 $i180c $i1810 $i1814 $i1818 $i181c $i1820 $i1824 $i1828 $i182c $i1830 $i1834 $i1838 $i183c $i1840 $i1844 $i1848 $i184c $i1850 $i1854 $i1858 $default
+    end
+      ;; 0x0 PUSH 0x220
+      i32.const 544
+      call $push
+      i32.const 8
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 8
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x10 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x14 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x18 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x1c NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x20 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x24 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x28 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x2c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x30 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0x38 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x3c NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x40 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x44 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x48 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0x50 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x58 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x60 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x68 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x70 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x74 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x78 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x80 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x84 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x8c LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x94 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x98 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x9c XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0xa0 CALL 0x30
+      i32.const 168
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xa8 LEAVE
+      call $leave
+    end
+      ;; 0xac POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xb0 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xb4 CALL 0x48
+      i32.const 188
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbc POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc0 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0xc4 CALL 0x48
+      i32.const 204
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xcc NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0xd0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd4 CALL 0x48
+      i32.const 220
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdc NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0xe0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe4 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0xec STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xf4 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xfc LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x104 COMPL
+      call $pop
+      i32.const -1
+      i32.xor
+      call $push
+    end
+      ;; 0x108 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x110 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x114 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x11c LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x124 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x128 COMPL
+      call $pop
+      i32.const -1
+      i32.xor
+      call $push
+    end
+      ;; 0x12c LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x134 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x13c SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x140 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x144 OR
+      call $pop
+      call $pop
+      i32.or
+      call $push
+    end
+      ;; 0x148 CALL 0x30
+      i32.const 336
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x150 LEAVE
+      call $leave
+    end
+      ;; 0x154 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x158 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x15c CALL 0xe4
+      i32.const 356
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x164 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x168 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0x170 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x178 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x180 PUSH 0x1b4
+      i32.const 436
+      call $push
+    end
+      ;; 0x188 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x190 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x198 CALL 0x48
+      i32.const 416
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1a0 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x1a4 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x1ac LEAVE
+      call $leave
+    end
+      ;; 0x1b0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1b4 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x1bc LEAVE
+      call $leave
+    end
+      ;; 0x1c0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1c4 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0x1cc STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x1d4 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x1dc PUSH 0x210
+      i32.const 528
+      call $push
+    end
+      ;; 0x1e4 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x1ec LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x1f4 CALL 0x48
+      i32.const 508
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1fc JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x200 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x208 LEAVE
+      call $leave
+    end
+      ;; 0x20c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x210 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x218 LEAVE
+      call $leave
+    end
+      ;; 0x21c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x220 NOP
+    end
+      ;; 0x224 PUSH 0x5a0
+      i32.const 1440
+      call $push
+    end
+      ;; 0x22c JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 556
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x230 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x238 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x23c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x240 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0x248 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x24c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x250 COMPL
+      call $pop
+      i32.const -1
+      i32.xor
+      call $push
+    end
+      ;; 0x254 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x25c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x260 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x264 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x268 CALL 0x30
+      i32.const 624
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x270 PUSH 0x288
+      i32.const 648
+      call $push
+    end
+      ;; 0x278 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x27c JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x280 CALL 0x250
+      i32.const 648
+      call $puship
+      i32.const 592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x288 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x28c ENTER 0x4
+      i32.const 4
+      call $enter
+    end
+      ;; 0x294 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x29c STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x2a4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x2ac STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x2b4 PUSH 0x2f0
+      i32.const 752
+      call $push
+    end
+      ;; 0x2bc LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x2c4 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x2cc AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x2d0 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x2d4 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x2dc LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x2e4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x2e8 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x2f0 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x2f8 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x300 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x304 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x30c LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x314 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x31c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x320 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x328 PUSH 0x2b4
+      i32.const 692
+      call $push
+    end
+      ;; 0x330 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x338 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x33c LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x344 LEAVE
+      call $leave
+    end
+      ;; 0x348 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x34c ENTER 0x5
+      i32.const 5
+      call $enter
+    end
+      ;; 0x354 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x35c STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x364 PUSH 0x20
+      i32.const 32
+      call $push
+    end
+      ;; 0x36c STORL 0x4
+      call $pop
+      local.set $a
+      i32.const 4
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x374 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x37c LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x384 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x388 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x390 CALL 0x30
+      i32.const 920
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x398 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x39c STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x3a4 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3ac LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3b4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x3b8 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x3c0 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3c8 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3d0 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x3d4 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x3dc PUSH 0x438
+      i32.const 1080
+      call $push
+    end
+      ;; 0x3e4 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3ec LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x3f4 CALL 0xe4
+      i32.const 1020
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x3fc JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x400 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x408 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x410 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x414 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x41c LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x424 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x42c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x430 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x438 LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x440 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0x448 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x44c STORL 0x4
+      call $pop
+      local.set $a
+      i32.const 4
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x454 PUSH 0x374
+      i32.const 884
+      call $push
+    end
+      ;; 0x45c LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x464 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x468 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x470 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x478 LEAVE
+      call $leave
+    end
+      ;; 0x47c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x480 ENTER 0x4
+      i32.const 4
+      call $enter
+    end
+      ;; 0x488 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x490 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x498 PUSH 0x4b8
+      i32.const 1208
+      call $push
+    end
+      ;; 0x4a0 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x4a8 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x4ac PUSH -0xa
+      i32.const -10
+      call $push
+    end
+      ;; 0x4b4 THROW
+      call $pop
+      call $throw
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4b8 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x4c0 CALL 0x264
+      i32.const 1224
+      call $puship
+      i32.const 612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4c8 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x4d0 CALL 0x264
+      i32.const 1240
+      call $puship
+      i32.const 612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4d8 CALL 0x34c
+      i32.const 1248
+      call $puship
+      i32.const 844
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x4e0 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x4e8 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x4f0 PUSH 0x524
+      i32.const 1316
+      call $push
+    end
+      ;; 0x4f8 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x500 CALL 0x30
+      i32.const 1288
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x508 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x50c LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x514 CALL 0x250
+      i32.const 1308
+      call $puship
+      i32.const 592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x51c STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x524 PUSH 0x564
+      i32.const 1380
+      call $push
+    end
+      ;; 0x52c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x534 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x53c XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x540 CALL 0x30
+      i32.const 1352
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x548 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x54c LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x554 CALL 0x250
+      i32.const 1372
+      call $puship
+      i32.const 592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x55c STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x564 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x56c LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x574 LEAVE
+      call $leave
+    end
+      ;; 0x578 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x57c CALL 0x480
+      i32.const 1412
+      call $puship
+      i32.const 1152
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x584 DROP
+      call $pop
+      drop
+    end
+      ;; 0x588 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x58c CALL 0x480
+      i32.const 1428
+      call $puship
+      i32.const 1152
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x594 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x598 DROP
+      call $pop
+      drop
+    end
+      ;; 0x59c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x5a0 NOP
+    end
+      ;; 0x5a4 PUSH 0x6c8
+      i32.const 1736
+      call $push
+    end
+      ;; 0x5ac JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 1452
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x5b0 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x5b8 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x5bc POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x5d0 ENTER 0x1
+      i32.const 1
+      call $enter
+    end
+      ;; 0x5d8 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x5e0 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x5e8 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x5ec DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x5f0 PUSH 0x62c
+      i32.const 1580
+      call $push
+    end
+      ;; 0x5f8 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x5fc JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x600 OUT
+      call $pop
+      i32.const 255
+      i32.and
+      call $out
+    end
+      ;; 0x604 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x60c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x614 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x618 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x620 PUSH 0x5e0
+      i32.const 1504
+      call $push
+    end
+      ;; 0x628 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 1576
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x62c DROP
+      call $pop
+      drop
+    end
+      ;; 0x630 LEAVE
+      call $leave
+    end
+      ;; 0x634 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x638 CALL 0x5d0
+      i32.const 1600
+      call $puship
+      i32.const 1488
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x640 CALL 0x5b0
+      i32.const 1608
+      call $puship
+      i32.const 1456
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x648 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x64c ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0x654 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x65c PUSH 0x6b8
+      i32.const 1720
+      call $push
+    end
+      ;; 0x664 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x66c LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x670 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x674 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x67c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x684 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x688 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x690 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x698 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x6a0 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x6a4 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x6ac PUSH 0x65c
+      i32.const 1628
+      call $push
+    end
+      ;; 0x6b4 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 1716
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x6b8 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x6c0 LEAVE
+      call $leave
+    end
+      ;; 0x6c4 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x6c8 NOP
+    end
+      ;; 0x6cc PUSH 0xa5c
+      i32.const 2652
+      call $push
+    end
+      ;; 0x6d4 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 1748
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x6d8 ENTER 0x5
+      i32.const 5
+      call $enter
+    end
+      ;; 0x6e0 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x6e8 PUSH 0x738
+      i32.const 1848
+      call $push
+    end
+      ;; 0x6f0 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x6f8 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x6fc PUSH 0x2d
+      i32.const 45
+      call $push
+    end
+      ;; 0x704 XOR
+      call $pop
+      call $pop
+      i32.xor
+      call $push
+    end
+      ;; 0x708 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x70c PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x714 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x71c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x724 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x72c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x730 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x738 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x740 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x744 PUSH 0x30
+      i32.const 48
+      call $push
+    end
+      ;; 0x74c SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x750 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x754 STORL 0x4
+      call $pop
+      local.set $a
+      i32.const 4
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x75c PUSH 0x804
+      i32.const 2052
+      call $push
+    end
+      ;; 0x764 LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x76c PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x774 CALL 0xe4
+      i32.const 1916
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x77c JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x780 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x788 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x790 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x794 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x798 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x79c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7a0 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x7a4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7a8 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7ac LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x7b4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7b8 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x7c0 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x7c8 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x7d0 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7d4 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x7dc LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x7e4 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x7ec ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x7f0 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x7f8 PUSH 0x738
+      i32.const 1848
+      call $push
+    end
+      ;; 0x800 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 2048
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x804 PUSH 0x838
+      i32.const 2104
+      call $push
+    end
+      ;; 0x80c LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x814 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x818 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x820 COMPL
+      call $pop
+      i32.const -1
+      i32.xor
+      call $push
+    end
+      ;; 0x824 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x82c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x830 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x838 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x840 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x848 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x84c NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x850 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x858 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x85c NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0x860 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x864 LEAVE
+      call $leave
+    end
+      ;; 0x868 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x86c ENTER 0x5
+      i32.const 5
+      call $enter
+    end
+      ;; 0x874 IN
+      call $input
+      call $push
+    end
+      ;; 0x878 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x880 PUSH 0x874
+      i32.const 2164
+      call $push
+    end
+      ;; 0x888 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x890 PUSH 0x20
+      i32.const 32
+      call $push
+    end
+      ;; 0x898 CALL 0xc
+      i32.const 2208
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x8a0 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x8a4 PUSH 0x874
+      i32.const 2164
+      call $push
+    end
+      ;; 0x8ac LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x8b4 PUSH 0x9
+      i32.const 9
+      call $push
+    end
+      ;; 0x8bc CALL 0xc
+      i32.const 2244
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x8c4 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x8c8 PUSH 0x874
+      i32.const 2164
+      call $push
+    end
+      ;; 0x8d0 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x8d8 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x8e0 CALL 0xc
+      i32.const 2280
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x8e8 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x8ec PUSH 0x874
+      i32.const 2164
+      call $push
+    end
+      ;; 0x8f4 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x8fc PUSH 0xd
+      i32.const 13
+      call $push
+    end
+      ;; 0x904 CALL 0xc
+      i32.const 2316
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x90c JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x910 PUSH 0x950
+      i32.const 2384
+      call $push
+    end
+      ;; 0x918 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x920 PUSH 0x2d
+      i32.const 45
+      call $push
+    end
+      ;; 0x928 CALL 0xc
+      i32.const 2352
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x930 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x934 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x93c STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x944 IN
+      call $input
+      call $push
+    end
+      ;; 0x948 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x950 LOADL 0x3
+      i32.const 3
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x958 PUSH 0x30
+      i32.const 48
+      call $push
+    end
+      ;; 0x960 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x964 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x968 STORL 0x4
+      call $pop
+      local.set $a
+      i32.const 4
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x970 PUSH 0xa08
+      i32.const 2568
+      call $push
+    end
+      ;; 0x978 LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x980 PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x988 CALL 0xe4
+      i32.const 2448
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x990 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x994 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x99c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x9a4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9a8 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x9ac DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x9b0 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9b4 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x9b8 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9bc ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9c0 LOADL 0x4
+      i32.const 4
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x9c8 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9cc STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x9d4 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0x9dc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x9e4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x9e8 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x9f0 IN
+      call $input
+      call $push
+    end
+      ;; 0x9f4 STORL 0x3
+      call $pop
+      local.set $a
+      i32.const 3
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0x9fc PUSH 0x950
+      i32.const 2384
+      call $push
+    end
+      ;; 0xa04 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 2564
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xa08 PUSH 0xa3c
+      i32.const 2620
+      call $push
+    end
+      ;; 0xa10 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa18 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xa1c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa24 COMPL
+      call $pop
+      i32.const -1
+      i32.xor
+      call $push
+    end
+      ;; 0xa28 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xa30 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xa34 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xa3c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa44 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa4c NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0xa50 NOT
+      call $pop
+      i32.eqz
+      call $push
+    end
+      ;; 0xa54 LEAVE
+      call $leave
+    end
+      ;; 0xa58 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xa5c NOP
+    end
+      ;; 0xa60 PUSH 0xc88
+      i32.const 3208
+      call $push
+    end
+      ;; 0xa68 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 2664
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xa6c ENTER 0x3
+      i32.const 3
+      call $enter
+    end
+      ;; 0xa74 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xa7c STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xa84 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xa8c PUSH 0xb18
+      i32.const 2840
+      call $push
+    end
+      ;; 0xa94 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xa9c JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xaa0 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xaa8 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xaac LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xab4 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0xab8 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xac0 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xac8 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xacc STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xad4 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xadc PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xae4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xae8 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xaf0 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xaf8 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0xb00 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xb04 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xb0c PUSH 0xa8c
+      i32.const 2700
+      call $push
+    end
+      ;; 0xb14 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 2836
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xb18 LEAVE
+      call $leave
+    end
+      ;; 0xb1c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xb20 ENTER 0x3
+      i32.const 3
+      call $enter
+    end
+      ;; 0xb28 STORL 0x2
+      call $pop
+      local.set $a
+      i32.const 2
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xb30 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xb38 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xb40 PUSH 0xbac
+      i32.const 2988
+      call $push
+    end
+      ;; 0xb48 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb50 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xb54 LOADL 0x2
+      i32.const 2
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb5c LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb64 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0xb68 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb70 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xb78 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xb7c STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xb84 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xb8c PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0xb94 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xb98 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xba0 PUSH 0xb40
+      i32.const 2880
+      call $push
+    end
+      ;; 0xba8 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 2984
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbac LEAVE
+      call $leave
+    end
+      ;; 0xbb0 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbb4 ENTER 0x1
+      i32.const 1
+      call $enter
+    end
+      ;; 0xbbc PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xbc4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xbc8 PUSH -0x4
+      i32.const -4
+      call $push
+    end
+      ;; 0xbd0 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0xbd4 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xbdc PUSH 0xc44
+      i32.const 3140
+      call $push
+    end
+      ;; 0xbe4 CALL 0xc54
+      i32.const 3052
+      call $puship
+      i32.const 3156
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbec LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xbf4 CALL 0xe4
+      i32.const 3068
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xbfc JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xc00 PUSH 0x185c
+      i32.const 6236
+      call $push
+    end
+      ;; 0xc08 PUSH 0x1185c
+      i32.const 71772
+      call $push
+    end
+      ;; 0xc10 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xc14 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xc18 PUSH 0x1185c
+      i32.const 71772
+      call $push
+    end
+      ;; 0xc20 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xc24 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xc2c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xc30 PUSH 0x1185c
+      i32.const 71772
+      call $push
+    end
+      ;; 0xc38 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0xc3c LEAVE
+      call $leave
+    end
+      ;; 0xc40 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc44 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xc4c LEAVE
+      call $leave
+    end
+      ;; 0xc50 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc54 PUSH 0x1185c
+      i32.const 71772
+      call $push
+    end
+      ;; 0xc5c LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xc60 PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0xc68 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0xc6c POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc70 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xc78 PUSH 0x1185c
+      i32.const 71772
+      call $push
+    end
+      ;; 0xc80 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0xc84 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc88 NOP
+    end
+      ;; 0xc8c PUSH 0xd6c
+      i32.const 3436
+      call $push
+    end
+      ;; 0xc94 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 3220
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xc98 ENTER 0x2
+      i32.const 2
+      call $enter
+    end
+      ;; 0xca0 STORL 0x1
+      call $pop
+      local.set $a
+      i32.const 1
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xca8 STORL 0x0
+      call $pop
+      local.set $a
+      i32.const 0
+      call $local
+      local.get $a
+      i32.store offset=4620288
+    end
+      ;; 0xcb0 PUSH 0x11860
+      i32.const 71776
+      call $push
+    end
+      ;; 0xcb8 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xcbc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xcc4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0xcc8 PUSH 0x11860
+      i32.const 71776
+      call $push
+    end
+      ;; 0xcd0 STOR
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      local.get $b
+      call $store
+    end
+      ;; 0xcd4 PUSH 0xd64
+      i32.const 3428
+      call $push
+    end
+      ;; 0xcdc LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xce4 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xcec CALL 0xc
+      i32.const 3316
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xcf4 JNZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0xcf8 PUSH 0x1720
+      i32.const 5920
+      call $push
+    end
+      ;; 0xd00 CALL 0x5d0
+      i32.const 3336
+      call $puship
+      i32.const 1488
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd08 PUSH 0x11860
+      i32.const 71776
+      call $push
+    end
+      ;; 0xd10 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0xd14 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0xd18 PUSH 0x173c
+      i32.const 5948
+      call $push
+    end
+      ;; 0xd20 CALL 0x5d0
+      i32.const 3368
+      call $puship
+      i32.const 1488
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd28 LOADL 0x0
+      i32.const 0
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xd30 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0xd34 PUSH 0x1774
+      i32.const 6004
+      call $push
+    end
+      ;; 0xd3c CALL 0x5d0
+      i32.const 3396
+      call $puship
+      i32.const 1488
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd44 LOADL 0x1
+      i32.const 1
+      call $local
+      i32.load offset=4620288
+      call $push
+    end
+      ;; 0xd4c OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0xd50 CALL 0x5b0
+      i32.const 3416
+      call $puship
+      i32.const 1456
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd58 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xd60 EXIT
+      call $pop
+      return
+    end
+      ;; 0xd64 LEAVE
+      call $leave
+    end
+      ;; 0xd68 POPIP
+      call $popip
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd6c PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xd74 PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xd7c CALL 0xc
+      i32.const 3460
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd84 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xd8c CALL 0xc98
+      i32.const 3476
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xd94 PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xd9c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xda4 CALL 0xc
+      i32.const 3500
+      call $puship
+      i32.const 12
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdac PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xdb4 CALL 0xc98
+      i32.const 3516
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdbc PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xdc4 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xdcc CALL 0x18
+      i32.const 3540
+      call $puship
+      i32.const 24
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdd4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xddc CALL 0xc98
+      i32.const 3556
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xde4 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xdec CALL 0x28
+      i32.const 3572
+      call $puship
+      i32.const 40
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xdf4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xdfc CALL 0xc98
+      i32.const 3588
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe04 PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0xe0c CALL 0x28
+      i32.const 3604
+      call $puship
+      i32.const 40
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe14 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xe1c CALL 0xc98
+      i32.const 3620
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe24 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0xe2c CALL 0x30
+      i32.const 3636
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe34 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xe3c CALL 0xc98
+      i32.const 3652
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe44 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xe4c CALL 0x30
+      i32.const 3668
+      call $puship
+      i32.const 48
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe54 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xe5c CALL 0xc98
+      i32.const 3684
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe64 PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0xe6c PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xe74 CALL 0x48
+      i32.const 3708
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe7c PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xe84 CALL 0xc98
+      i32.const 3724
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xe8c PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xe94 PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0xe9c CALL 0x48
+      i32.const 3748
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xea4 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xeac CALL 0xc98
+      i32.const 3764
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xeb4 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0xebc PUSH 0x7fffffff
+      i32.const 2147483647
+      call $push
+    end
+      ;; 0xec4 CALL 0x48
+      i32.const 3788
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xecc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xed4 CALL 0xc98
+      i32.const 3804
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xedc PUSH 0x7fffffff
+      i32.const 2147483647
+      call $push
+    end
+      ;; 0xee4 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0xeec CALL 0x48
+      i32.const 3828
+      call $puship
+      i32.const 72
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xef4 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xefc CALL 0xc98
+      i32.const 3844
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf04 PUSH 0x7fffffff
+      i32.const 2147483647
+      call $push
+    end
+      ;; 0xf0c PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0xf14 CALL 0xb0
+      i32.const 3868
+      call $puship
+      i32.const 176
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf1c PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xf24 CALL 0xc98
+      i32.const 3884
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf2c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xf34 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xf3c CALL 0xc0
+      i32.const 3908
+      call $puship
+      i32.const 192
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf44 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xf4c CALL 0xc98
+      i32.const 3924
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf54 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0xf5c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xf64 CALL 0xc0
+      i32.const 3948
+      call $puship
+      i32.const 192
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf6c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xf74 CALL 0xc98
+      i32.const 3964
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf7c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xf84 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xf8c CALL 0xd4
+      i32.const 3988
+      call $puship
+      i32.const 212
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xf94 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xf9c CALL 0xc98
+      i32.const 4004
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xfa4 PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0xfac PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0xfb4 CALL 0xd4
+      i32.const 4028
+      call $puship
+      i32.const 212
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xfbc PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0xfc4 CALL 0xc98
+      i32.const 4044
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xfcc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xfd4 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0xfdc CALL 0xe4
+      i32.const 4068
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xfe4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0xfec CALL 0xc98
+      i32.const 4084
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0xff4 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0xffc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x1004 CALL 0xe4
+      i32.const 4108
+      call $puship
+      i32.const 228
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x100c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x1014 CALL 0xc98
+      i32.const 4124
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x101c PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0x1024 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x102c CALL 0x158
+      i32.const 4148
+      call $puship
+      i32.const 344
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1034 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x103c CALL 0xc98
+      i32.const 4164
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1044 PUSH -0x3
+      i32.const -3
+      call $push
+    end
+      ;; 0x104c PUSH 0x8
+      i32.const 8
+      call $push
+    end
+      ;; 0x1054 CALL 0x168
+      i32.const 4188
+      call $puship
+      i32.const 360
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x105c PUSH -0x3
+      i32.const -3
+      call $push
+    end
+      ;; 0x1064 CALL 0xc98
+      i32.const 4204
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x106c PUSH -0x3
+      i32.const -3
+      call $push
+    end
+      ;; 0x1074 PUSH 0x8
+      i32.const 8
+      call $push
+    end
+      ;; 0x107c CALL 0x1c4
+      i32.const 4228
+      call $puship
+      i32.const 452
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1084 PUSH 0x8
+      i32.const 8
+      call $push
+    end
+      ;; 0x108c CALL 0xc98
+      i32.const 4244
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1094 PUSH 0x29
+      i32.const 41
+      call $push
+    end
+      ;; 0x109c CALL 0x230
+      i32.const 4260
+      call $puship
+      i32.const 560
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10a4 PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x10ac CALL 0xc98
+      i32.const 4276
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10b4 PUSH 0x2b
+      i32.const 43
+      call $push
+    end
+      ;; 0x10bc CALL 0x240
+      i32.const 4292
+      call $puship
+      i32.const 576
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10c4 PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x10cc CALL 0xc98
+      i32.const 4308
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10d4 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x10dc CALL 0x250
+      i32.const 4324
+      call $puship
+      i32.const 592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10e4 PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0x10ec CALL 0xc98
+      i32.const 4340
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x10f4 PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0x10fc CALL 0x264
+      i32.const 4356
+      call $puship
+      i32.const 612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1104 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x110c CALL 0xc98
+      i32.const 4372
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1114 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x111c CALL 0x264
+      i32.const 4388
+      call $puship
+      i32.const 612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1124 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x112c CALL 0xc98
+      i32.const 4404
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1134 PUSH 0x6
+      i32.const 6
+      call $push
+    end
+      ;; 0x113c PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x1144 CALL 0x28c
+      i32.const 4428
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x114c PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x1154 CALL 0xc98
+      i32.const 4444
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x115c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x1164 PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x116c CALL 0x28c
+      i32.const 4468
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1174 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x117c CALL 0xc98
+      i32.const 4484
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1184 PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x118c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x1194 CALL 0x28c
+      i32.const 4508
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x119c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x11a4 CALL 0xc98
+      i32.const 4524
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x11ac PUSH -0x6
+      i32.const -6
+      call $push
+    end
+      ;; 0x11b4 PUSH 0x7
+      i32.const 7
+      call $push
+    end
+      ;; 0x11bc CALL 0x28c
+      i32.const 4548
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x11c4 PUSH -0x2a
+      i32.const -42
+      call $push
+    end
+      ;; 0x11cc CALL 0xc98
+      i32.const 4564
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x11d4 PUSH -0x6
+      i32.const -6
+      call $push
+    end
+      ;; 0x11dc PUSH -0x7
+      i32.const -7
+      call $push
+    end
+      ;; 0x11e4 CALL 0x28c
+      i32.const 4588
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x11ec PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x11f4 CALL 0xc98
+      i32.const 4604
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x11fc PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x1204 PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x120c CALL 0x28c
+      i32.const 4628
+      call $puship
+      i32.const 652
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1214 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x121c CALL 0xc98
+      i32.const 4644
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1224 PUSH 0x2f
+      i32.const 47
+      call $push
+    end
+      ;; 0x122c PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x1234 CALL 0x57c
+      i32.const 4668
+      call $puship
+      i32.const 1404
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x123c PUSH 0x9
+      i32.const 9
+      call $push
+    end
+      ;; 0x1244 CALL 0xc98
+      i32.const 4684
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x124c PUSH 0x2f
+      i32.const 47
+      call $push
+    end
+      ;; 0x1254 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x125c CALL 0x58c
+      i32.const 4708
+      call $puship
+      i32.const 1420
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1264 PUSH 0x2
+      i32.const 2
+      call $push
+    end
+      ;; 0x126c CALL 0xc98
+      i32.const 4724
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1274 PUSH -0x2f
+      i32.const -47
+      call $push
+    end
+      ;; 0x127c PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x1284 CALL 0x57c
+      i32.const 4748
+      call $puship
+      i32.const 1404
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x128c PUSH -0x9
+      i32.const -9
+      call $push
+    end
+      ;; 0x1294 CALL 0xc98
+      i32.const 4764
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x129c PUSH -0x2f
+      i32.const -47
+      call $push
+    end
+      ;; 0x12a4 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x12ac CALL 0x58c
+      i32.const 4788
+      call $puship
+      i32.const 1420
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x12b4 PUSH -0x2
+      i32.const -2
+      call $push
+    end
+      ;; 0x12bc CALL 0xc98
+      i32.const 4804
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x12c4 PUSH -0x2f
+      i32.const -47
+      call $push
+    end
+      ;; 0x12cc PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x12d4 CALL 0x480
+      i32.const 4828
+      call $puship
+      i32.const 1152
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x12dc PUSH -0x2
+      i32.const -2
+      call $push
+    end
+      ;; 0x12e4 CALL 0xc98
+      i32.const 4844
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x12ec PUSH -0x9
+      i32.const -9
+      call $push
+    end
+      ;; 0x12f4 CALL 0xc98
+      i32.const 4860
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x12fc PUSH 0x2f
+      i32.const 47
+      call $push
+    end
+      ;; 0x1304 PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0x130c CALL 0x57c
+      i32.const 4884
+      call $puship
+      i32.const 1404
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1314 PUSH -0x9
+      i32.const -9
+      call $push
+    end
+      ;; 0x131c CALL 0xc98
+      i32.const 4900
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1324 PUSH 0x2f
+      i32.const 47
+      call $push
+    end
+      ;; 0x132c PUSH -0x5
+      i32.const -5
+      call $push
+    end
+      ;; 0x1334 CALL 0x58c
+      i32.const 4924
+      call $puship
+      i32.const 1420
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x133c PUSH 0x2
+      i32.const 2
+      call $push
+    end
+      ;; 0x1344 CALL 0xc98
+      i32.const 4940
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x134c PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0x1354 PUSH -0x1
+      i32.const -1
+      call $push
+    end
+      ;; 0x135c CALL 0x57c
+      i32.const 4964
+      call $puship
+      i32.const 1404
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1364 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0x136c CALL 0xc98
+      i32.const 4980
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1374 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0x137c PUSH 0xa
+      i32.const 10
+      call $push
+    end
+      ;; 0x1384 CALL 0x58c
+      i32.const 5004
+      call $puship
+      i32.const 1420
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x138c PUSH -0x8
+      i32.const -8
+      call $push
+    end
+      ;; 0x1394 CALL 0xc98
+      i32.const 5020
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x139c TRY 0x13d8
+      i32.const 5080
+      call $try
+    end
+      ;; 0x13a4 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x13ac PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x13b4 CALL 0x57c
+      i32.const 5052
+      call $puship
+      i32.const 1404
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x13bc ENDTRY
+      call $endtry
+    end
+      ;; 0x13c0 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x13c8 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x13d0 CALL 0xc98
+      i32.const 5080
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x13d8 PUSH -0xa
+      i32.const -10
+      call $push
+    end
+      ;; 0x13e0 CALL 0xc98
+      i32.const 5096
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x13e8 PUSH 0x17e0
+      i32.const 6112
+      call $push
+    end
+      ;; 0x13f0 CALL 0x64c
+      i32.const 5112
+      call $puship
+      i32.const 1612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x13f8 PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x1400 CALL 0xc98
+      i32.const 5128
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1408 PUSH 0x1844
+      i32.const 6212
+      call $push
+    end
+      ;; 0x1410 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x1418 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x141c CALL 0x64c
+      i32.const 5156
+      call $puship
+      i32.const 1612
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1424 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x142c CALL 0xc98
+      i32.const 5172
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1434 PUSH 0x17f8
+      i32.const 6136
+      call $push
+    end
+      ;; 0x143c CALL 0x6d8
+      i32.const 5188
+      call $puship
+      i32.const 1752
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1444 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x144c CALL 0xc98
+      i32.const 5204
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1454 PUSH -0x80000000
+      i32.const -2147483648
+      call $push
+    end
+      ;; 0x145c CALL 0xc98
+      i32.const 5220
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1464 PUSH 0x1828
+      i32.const 6184
+      call $push
+    end
+      ;; 0x146c CALL 0x6d8
+      i32.const 5236
+      call $puship
+      i32.const 1752
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1474 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x147c CALL 0xc98
+      i32.const 5252
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1484 PUSH 0x2a
+      i32.const 42
+      call $push
+    end
+      ;; 0x148c CALL 0xc98
+      i32.const 5268
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1494 PUSH 0x1834
+      i32.const 6196
+      call $push
+    end
+      ;; 0x149c CALL 0x6d8
+      i32.const 5284
+      call $puship
+      i32.const 1752
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x14a4 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x14ac CALL 0xc98
+      i32.const 5300
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x14b4 DROP
+      call $pop
+      drop
+    end
+      ;; 0x14b8 PUSH 0x1844
+      i32.const 6212
+      call $push
+    end
+      ;; 0x14c0 CALL 0x6d8
+      i32.const 5320
+      call $puship
+      i32.const 1752
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x14c8 PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x14d0 CALL 0xc98
+      i32.const 5336
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x14d8 DROP
+      call $pop
+      drop
+    end
+      ;; 0x14dc CALL 0x86c
+      i32.const 5348
+      call $puship
+      i32.const 2156
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x14e4 DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x14e8 PUSH 0x1524
+      i32.const 5412
+      call $push
+    end
+      ;; 0x14f0 SWAP
+      call $pop
+      local.set $b
+      call $pop
+      local.set $a
+      local.get $b
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x14f4 JZ
+      call $pop
+      local.set $a
+      call $pop
+      local.set $b
+      local.get $a
+      i32.eqz
+      if
+        local.get $b
+        call $check
+        local.set $pc
+        br $dispatch
+      end
+    end
+      ;; 0x14f8 PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x1500 CALL 0xc98
+      i32.const 5384
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1508 PUSH -0x7b
+      i32.const -123
+      call $push
+    end
+      ;; 0x1510 CALL 0xc98
+      i32.const 5400
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1518 PUSH 0x152c
+      i32.const 5420
+      call $push
+    end
+      ;; 0x1520 JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 5408
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1524 DROP
+      call $pop
+      drop
+    end
+      ;; 0x1528 DROP
+      call $pop
+      drop
+    end
+      ;; 0x152c PUSH 0x184c
+      i32.const 6220
+      call $push
+    end
+      ;; 0x1534 PUSH 0x11864
+      i32.const 71780
+      call $push
+    end
+      ;; 0x153c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x1544 CALL 0xa6c
+      i32.const 5452
+      call $puship
+      i32.const 2668
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x154c PUSH 0x11864
+      i32.const 71780
+      call $push
+    end
+      ;; 0x1554 PUSH 0xc
+      i32.const 12
+      call $push
+    end
+      ;; 0x155c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x1560 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x1564 PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x156c CALL 0xc98
+      i32.const 5492
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1574 PUSH 0x11864
+      i32.const 71780
+      call $push
+    end
+      ;; 0x157c PUSH 0x2
+      i32.const 2
+      call $push
+    end
+      ;; 0x1584 PUSH 0x9
+      i32.const 9
+      call $push
+    end
+      ;; 0x158c CALL 0xb20
+      i32.const 5524
+      call $puship
+      i32.const 2848
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1594 PUSH 0x11864
+      i32.const 71780
+      call $push
+    end
+      ;; 0x159c PUSH 0x4
+      i32.const 4
+      call $push
+    end
+      ;; 0x15a4 ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x15a8 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x15ac PUSH 0x9
+      i32.const 9
+      call $push
+    end
+      ;; 0x15b4 CALL 0xc98
+      i32.const 5564
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x15bc PUSH 0x11864
+      i32.const 71780
+      call $push
+    end
+      ;; 0x15c4 PUSH 0x8
+      i32.const 8
+      call $push
+    end
+      ;; 0x15cc ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x15d0 LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x15d4 PUSH 0x3
+      i32.const 3
+      call $push
+    end
+      ;; 0x15dc CALL 0xc98
+      i32.const 5604
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x15e4 CALL 0xc54
+      i32.const 5612
+      call $puship
+      i32.const 3156
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x15ec PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x15f4 CALL 0xc98
+      i32.const 5628
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x15fc PUSH 0x5
+      i32.const 5
+      call $push
+    end
+      ;; 0x1604 CALL 0xbb4
+      i32.const 5644
+      call $puship
+      i32.const 2996
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x160c DUP
+      call $pop
+      local.tee $a
+      call $push
+      local.get $a
+      call $push
+    end
+      ;; 0x1610 PUSH 0x185c
+      i32.const 6236
+      call $push
+    end
+      ;; 0x1618 CALL 0xc98
+      i32.const 5664
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1620 PUSH 0x9
+      i32.const 9
+      call $push
+    end
+      ;; 0x1628 CALL 0xbb4
+      i32.const 5680
+      call $puship
+      i32.const 2996
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1630 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x1634 PUSH 0x8
+      i32.const 8
+      call $push
+    end
+      ;; 0x163c CALL 0xc98
+      i32.const 5700
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1644 CALL 0xc54
+      i32.const 5708
+      call $puship
+      i32.const 3156
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x164c PUSH 0xffec
+      i32.const 65516
+      call $push
+    end
+      ;; 0x1654 CALL 0xc98
+      i32.const 5724
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x165c PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x1664 CALL 0xbb4
+      i32.const 5740
+      call $puship
+      i32.const 2996
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x166c PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x1674 CALL 0xc98
+      i32.const 5756
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x167c CALL 0xc70
+      i32.const 5764
+      call $puship
+      i32.const 3184
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1684 CALL 0xc54
+      i32.const 5772
+      call $puship
+      i32.const 3156
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x168c PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x1694 CALL 0xc98
+      i32.const 5788
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x169c PUSH 0x10000
+      i32.const 65536
+      call $push
+    end
+      ;; 0x16a4 CALL 0xbb4
+      i32.const 5804
+      call $puship
+      i32.const 2996
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16ac PUSH 0x185c
+      i32.const 6236
+      call $push
+    end
+      ;; 0x16b4 CALL 0xc98
+      i32.const 5820
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16bc PUSH 0x1
+      i32.const 1
+      call $push
+    end
+      ;; 0x16c4 CALL 0xbb4
+      i32.const 5836
+      call $puship
+      i32.const 2996
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16cc PUSH 0x0
+      i32.const 0
+      call $push
+    end
+      ;; 0x16d4 CALL 0xc98
+      i32.const 5852
+      call $puship
+      i32.const 3224
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16dc CALL 0xc70
+      i32.const 5860
+      call $puship
+      i32.const 3184
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16e4 PUSH 0x17e0
+      i32.const 6112
+      call $push
+    end
+      ;; 0x16ec CALL 0x638
+      i32.const 5876
+      call $puship
+      i32.const 1592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x16f4 PUSH 0x11860
+      i32.const 71776
+      call $push
+    end
+      ;; 0x16fc LOAD
+      call $pop
+      call $load
+      call $push
+    end
+      ;; 0x1700 OUTNUM
+      call $pop
+      call $outnum
+    end
+      ;; 0x1704 PUSH 0x17a4
+      i32.const 6052
+      call $push
+    end
+      ;; 0x170c CALL 0x638
+      i32.const 5908
+      call $puship
+      i32.const 1592
+      call $check
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1714 PUSH 0x171c
+      i32.const 5916
+      call $push
+    end
+      ;; 0x171c JMP
+      call $pop
+      call $check
+      local.tee $a
+      i32.const 5916
+      i32.eq
+      if
+        i32.const 0
+        return
+      end
+      local.get $a
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1720 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1724 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1728 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x172c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1730 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1734 CALLS
+      call $pop
+      call $check
+      i32.const 5944
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1738 NOP
+    end
+      ;; 0x173c CALLS
+      call $pop
+      call $check
+      i32.const 5952
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1740 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1744 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1748 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x174c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1750 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1754 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1758 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x175c CALLS
+      call $pop
+      call $check
+      i32.const 5984
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1760 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1764 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1768 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x176c CALLS
+      call $pop
+      call $check
+      i32.const 6000
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x1770 NOP
+    end
+      ;; 0x1774 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1778 CALLS
+      call $pop
+      call $check
+      i32.const 6012
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x177c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1780 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1784 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1788 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x178c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1790 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1794 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1798 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x179c CALLS
+      call $pop
+      call $check
+      i32.const 6048
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x17a0 NOP
+    end
+      ;; 0x17a4 CALLS
+      call $pop
+      call $check
+      i32.const 6056
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x17a8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17ac <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17b0 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17b4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17b8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17bc <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17c0 CALLS
+      call $pop
+      call $check
+      i32.const 6084
+      call $puship
+      local.set $pc
+      br $dispatch
+    end
+      ;; 0x17c4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17c8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17cc <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17d0 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17d4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17d8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17dc NOP
+    end
+      ;; 0x17e0 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17e4 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17e8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17ec <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17f0 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17f4 NOP
+    end
+      ;; 0x17f8 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x17fc <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1800 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1804 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1808 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x180c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1810 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1814 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1818 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x181c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1820 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1824 NOP
+    end
+      ;; 0x1828 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x182c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1830 NOP
+    end
+      ;; 0x1834 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1838 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x183c <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1840 NOP
+    end
+      ;; 0x1844 <?>
+      i32.const -4
+      call $fail
+    end
+      ;; 0x1848 NOP
+    end
+      ;; 0x184c ADD
+      call $pop
+      call $pop
+      i32.add
+      call $push
+    end
+      ;; 0x1850 SUB
+      call $pop
+      call $pop
+      i32.sub
+      call $push
+    end
+      ;; 0x1854 AND
+      call $pop
+      call $pop
+      i32.and
+      call $push
+    end
+      ;; 0x1858 OR
+      call $pop
+      call $pop
+      i32.or
+      call $push
+      i32.const 6236
+      local.set $pc
+      br $dispatch
+    end
+      i32.const -8
+      call $fail
+    end
+    unreachable)
+)
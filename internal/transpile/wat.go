@@ -0,0 +1,609 @@
+package transpile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matt-dunleavy/stackmachine-go/internal/vm"
+)
+
+// Linear memory layout of generated WebAssembly modules. VM memory comes
+// first, one 4-byte cell per VM address, followed by the machine's stacks.
+const (
+	watMemWords     = 1000 * 1024                      // VM memory size in words
+	watStackWords   = 65536                            // Capacity of each stack
+	watMaxHandlers  = 4096                             // Capacity of the handler stack
+	watStackBase    = watMemWords * 4                  // Data stack
+	watIPStackBase  = watStackBase + watStackWords*4   // IP stack
+	watFrameBase    = watIPStackBase + watStackWords*4 // Frame stack
+	watHandlerBase  = watFrameBase + watStackWords*4   // Handler stack, 5 words per entry
+	watScratchBase  = watHandlerBase + watMaxHandlers*20
+	watMemoryEnd    = watScratchBase + 16
+	watPageSize     = 65536
+	watMemoryPages  = (watMemoryEnd + watPageSize - 1) / watPageSize
+	watHandlerBytes = 20
+)
+
+// Codes passed to the imported fault function. The first five match the
+// VM's fault codes.
+const (
+	watFaultUncaught     = -6 // THROW without handler
+	watFaultEndTry       = -7 // ENDTRY without TRY
+	watFaultUntranslated = -8 // jump to an address that was not translated
+	watFaultOverflow     = -9 // stack overflow
+)
+
+// watRuntime holds the helper functions shared by every generated module
+var watRuntime = strings.NewReplacer(
+	"MEM_WORDS", fmt.Sprint(watMemWords),
+	"STACK_WORDS", fmt.Sprint(watStackWords),
+	"MAX_HANDLERS", fmt.Sprint(watMaxHandlers),
+	"HANDLER_BYTES", fmt.Sprint(watHandlerBytes),
+	"STACK_BASE", fmt.Sprint(watStackBase),
+	"IPSTACK_BASE", fmt.Sprint(watIPStackBase),
+	"FRAME_BASE", fmt.Sprint(watFrameBase),
+	"HANDLER_ADDR", fmt.Sprint(watHandlerBase),
+	"HANDLER_SP", fmt.Sprint(watHandlerBase+4),
+	"HANDLER_IPSP", fmt.Sprint(watHandlerBase+8),
+	"HANDLER_FSP", fmt.Sprint(watHandlerBase+12),
+	"HANDLER_FP", fmt.Sprint(watHandlerBase+16),
+	"SCRATCH_BASE", fmt.Sprint(watScratchBase),
+	"FAULT_UNCAUGHT", fmt.Sprint(watFaultUncaught),
+	"FAULT_ENDTRY", fmt.Sprint(watFaultEndTry),
+	"FAULT_OVERFLOW", fmt.Sprint(watFaultOverflow),
+).Replace(`
+  (global $sp (mut i32) (i32.const 0))
+  (global $ipsp (mut i32) (i32.const 0))
+  (global $fsp (mut i32) (i32.const 0))
+  (global $fp (mut i32) (i32.const 0))
+  (global $hsp (mut i32) (i32.const 0))
+
+  (func $fail (param $code i32)
+    local.get $code
+    call $fault
+    unreachable)
+
+  (func $push (param $n i32)
+    global.get $sp
+    i32.const STACK_WORDS
+    i32.ge_u
+    if
+      i32.const FAULT_OVERFLOW
+      call $fail
+    end
+    global.get $sp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=STACK_BASE
+    global.get $sp
+    i32.const 1
+    i32.add
+    global.set $sp)
+
+  (func $pop (result i32)
+    global.get $sp
+    i32.eqz
+    if
+      i32.const -1
+      call $fail
+    end
+    global.get $sp
+    i32.const 1
+    i32.sub
+    global.set $sp
+    global.get $sp
+    i32.const 2
+    i32.shl
+    i32.load offset=STACK_BASE)
+
+  (func $puship (param $n i32)
+    global.get $ipsp
+    i32.const STACK_WORDS
+    i32.ge_u
+    if
+      i32.const FAULT_OVERFLOW
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    local.get $n
+    i32.store offset=IPSTACK_BASE
+    global.get $ipsp
+    i32.const 1
+    i32.add
+    global.set $ipsp)
+
+  (func $popip (result i32)
+    global.get $ipsp
+    i32.eqz
+    if
+      i32.const -2
+      call $fail
+    end
+    global.get $ipsp
+    i32.const 1
+    i32.sub
+    global.set $ipsp
+    global.get $ipsp
+    i32.const 2
+    i32.shl
+    i32.load offset=IPSTACK_BASE)
+
+  (func $check (param $a i32) (result i32)
+    local.get $a
+    i32.const MEM_WORDS
+    i32.ge_u
+    if
+      i32.const -3
+      call $fail
+    end
+    local.get $a)
+
+  (func $load (param $a i32) (result i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    i32.load)
+
+  (func $store (param $a i32) (param $v i32)
+    local.get $a
+    call $check
+    i32.const 2
+    i32.shl
+    local.get $v
+    i32.store)
+
+  (func $input (result i32)
+    (local $b i32)
+    call $in
+    local.tee $b
+    i32.const 0
+    i32.lt_s
+    if (result i32)
+      i32.const 0
+    else
+      local.get $b
+    end)
+
+  (func $outnum (param $n i32)
+    (local $v i64)
+    (local $p i32)
+    local.get $n
+    i64.extend_i32_s
+    local.set $v
+    local.get $v
+    i64.const 0
+    i64.lt_s
+    if
+      i32.const 45
+      call $out
+      i64.const 0
+      local.get $v
+      i64.sub
+      local.set $v
+    end
+    loop $digits
+      local.get $p
+      local.get $v
+      i64.const 10
+      i64.rem_u
+      i32.wrap_i64
+      i32.const 48
+      i32.add
+      i32.store8 offset=SCRATCH_BASE
+      local.get $p
+      i32.const 1
+      i32.add
+      local.set $p
+      local.get $v
+      i64.const 10
+      i64.div_u
+      local.tee $v
+      i64.const 0
+      i64.ne
+      br_if $digits
+    end
+    loop $emit
+      local.get $p
+      i32.const 1
+      i32.sub
+      local.tee $p
+      i32.load8_u offset=SCRATCH_BASE
+      call $out
+      local.get $p
+      br_if $emit
+    end)
+
+  (func $try (param $addr i32)
+    (local $h i32)
+    global.get $hsp
+    i32.const MAX_HANDLERS
+    i32.ge_u
+    if
+      i32.const FAULT_OVERFLOW
+      call $fail
+    end
+    global.get $hsp
+    i32.const HANDLER_BYTES
+    i32.mul
+    local.set $h
+    local.get $h
+    local.get $addr
+    i32.store offset=HANDLER_ADDR
+    local.get $h
+    global.get $sp
+    i32.store offset=HANDLER_SP
+    local.get $h
+    global.get $ipsp
+    i32.store offset=HANDLER_IPSP
+    local.get $h
+    global.get $fsp
+    i32.store offset=HANDLER_FSP
+    local.get $h
+    global.get $fp
+    i32.store offset=HANDLER_FP
+    global.get $hsp
+    i32.const 1
+    i32.add
+    global.set $hsp)
+
+  (func $endtry
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const FAULT_ENDTRY
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp)
+
+  (func $throw (param $code i32) (result i32)
+    (local $h i32)
+    global.get $hsp
+    i32.eqz
+    if
+      i32.const FAULT_UNCAUGHT
+      call $fail
+    end
+    global.get $hsp
+    i32.const 1
+    i32.sub
+    global.set $hsp
+    global.get $hsp
+    i32.const HANDLER_BYTES
+    i32.mul
+    local.set $h
+    local.get $h
+    i32.load offset=HANDLER_SP
+    global.get $sp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=HANDLER_SP
+      global.set $sp
+    end
+    local.get $h
+    i32.load offset=HANDLER_IPSP
+    global.get $ipsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=HANDLER_IPSP
+      global.set $ipsp
+    end
+    local.get $h
+    i32.load offset=HANDLER_FSP
+    global.get $fsp
+    i32.lt_u
+    if
+      local.get $h
+      i32.load offset=HANDLER_FSP
+      global.set $fsp
+    end
+    local.get $h
+    i32.load offset=HANDLER_FP
+    global.set $fp
+    local.get $h
+    i32.load offset=HANDLER_ADDR
+    call $check
+    drop
+    local.get $code
+    call $push
+    local.get $h
+    i32.load offset=HANDLER_ADDR)
+
+  (func $enter (param $n i32)
+    (local $i i32)
+    local.get $n
+    i32.const 0
+    i32.lt_s
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fsp
+    local.get $n
+    i32.add
+    i32.const STACK_WORDS
+    i32.ge_u
+    if
+      i32.const FAULT_OVERFLOW
+      call $fail
+    end
+    global.get $fsp
+    i32.const 2
+    i32.shl
+    global.get $fp
+    i32.store offset=FRAME_BASE
+    global.get $fsp
+    i32.const 1
+    i32.add
+    global.set $fsp
+    global.get $fsp
+    global.set $fp
+    block $done
+      loop $zero
+        local.get $i
+        local.get $n
+        i32.ge_u
+        br_if $done
+        global.get $fsp
+        i32.const 2
+        i32.shl
+        i32.const 0
+        i32.store offset=FRAME_BASE
+        global.get $fsp
+        i32.const 1
+        i32.add
+        global.set $fsp
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $zero
+      end
+    end)
+
+  (func $leave
+    (local $saved i32)
+    global.get $fp
+    i32.eqz
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    i32.const 1
+    i32.sub
+    i32.const 2
+    i32.shl
+    i32.load offset=FRAME_BASE
+    local.set $saved
+    global.get $fp
+    i32.const 1
+    i32.sub
+    global.set $fsp
+    local.get $saved
+    global.set $fp)
+
+  (func $local (param $i i32) (result i32)
+    global.get $fp
+    i32.eqz
+    global.get $fp
+    local.get $i
+    i32.add
+    global.get $fsp
+    i32.ge_u
+    i32.or
+    if
+      i32.const -5
+      call $fail
+    end
+    global.get $fp
+    local.get $i
+    i32.add
+    i32.const 2
+    i32.shl)
+
+  (func $index (param $pc i32) (result i32)
+    local.get $pc
+    i32.const 3
+    i32.and
+    if (result i32)
+      i32.const -1
+    else
+      local.get $pc
+      i32.const 2
+      i32.shr_u
+    end)
+`)
+
+// watStraight maps instructions that continue with the next instruction to
+// their code; %d is replaced by the immediate word
+var watStraight = map[vm.Op]string{
+	vm.NOP:    "",
+	vm.PUSH:   "i32.const %d\ncall $push",
+	vm.ADD:    "call $pop\ncall $pop\ni32.add\ncall $push",
+	vm.SUB:    "call $pop\ncall $pop\ni32.sub\ncall $push",
+	vm.AND:    "call $pop\ncall $pop\ni32.and\ncall $push",
+	vm.OR:     "call $pop\ncall $pop\ni32.or\ncall $push",
+	vm.XOR:    "call $pop\ncall $pop\ni32.xor\ncall $push",
+	vm.NOT:    "call $pop\ni32.eqz\ncall $push",
+	vm.COMPL:  "call $pop\ni32.const -1\ni32.xor\ncall $push",
+	vm.IN:     "call $input\ncall $push",
+	vm.OUT:    "call $pop\ni32.const 255\ni32.and\ncall $out",
+	vm.OUTNUM: "call $pop\ncall $outnum",
+	vm.LOAD:   "call $pop\ncall $load\ncall $push",
+	vm.STOR:   "call $pop\nlocal.set $a\ncall $pop\nlocal.set $b\nlocal.get $a\nlocal.get $b\ncall $store",
+	vm.DROP:   "call $pop\ndrop",
+	vm.DUP:    "call $pop\nlocal.tee $a\ncall $push\nlocal.get $a\ncall $push",
+	vm.SWAP:   "call $pop\nlocal.set $b\ncall $pop\nlocal.set $a\nlocal.get $b\ncall $push\nlocal.get $a\ncall $push",
+	vm.ROL3:   "call $pop\nlocal.set $c\ncall $pop\nlocal.set $b\ncall $pop\nlocal.set $a\nlocal.get $b\ncall $push\nlocal.get $c\ncall $push\nlocal.get $a\ncall $push",
+	vm.PUSHIP: "i32.const %d\ncall $puship",
+	vm.DROPIP: "call $popip\ndrop",
+	vm.TRY:    "i32.const %d\ncall $try",
+	vm.ENDTRY: "call $endtry",
+	vm.ENTER:  "i32.const %d\ncall $enter",
+	vm.LEAVE:  "call $leave",
+	vm.LOADL:  "i32.const %d\ncall $local\ni32.load offset=" + fmt.Sprint(watFrameBase) + "\ncall $push",
+	vm.STORL:  "call $pop\nlocal.set $a\ni32.const %d\ncall $local\nlocal.get $a\ni32.store offset=" + fmt.Sprint(watFrameBase),
+}
+
+// WAT writes a WebAssembly text module that runs image. VM memory is
+// mapped onto linear memory, OUT and IN onto the imported functions
+// env.out and env.in, and runtime faults onto env.fault. The exported
+// function run executes the program and returns its exit status.
+//
+// Indirect jumps go through a br_table dispatch loop with one case per
+// instruction reachable from the entry point; consecutive instructions fall
+// through to each other without dispatch.
+func WAT(w io.Writer, image []int32, opts Options) error {
+	bw := bufio.NewWriter(w)
+	code := vm.Reachable(image, opts.Entry)
+
+	fmt.Fprintf(bw, ";; Generated by smg from %s\n", opts.Name)
+	fmt.Fprintf(bw, "(module\n")
+	fmt.Fprintf(bw, "  (import \"env\" \"out\" (func $out (param i32)))\n")
+	fmt.Fprintf(bw, "  (import \"env\" \"in\" (func $in (result i32)))\n")
+	fmt.Fprintf(bw, "  (import \"env\" \"fault\" (func $fault (param i32)))\n")
+	fmt.Fprintf(bw, "  (memory (export \"memory\") %d)\n", watMemoryPages)
+	writeWATData(bw, image)
+	io.WriteString(bw, watRuntime)
+
+	fmt.Fprintf(bw, "\n  (func $run (export \"run\") (result i32)\n")
+	fmt.Fprintf(bw, "    (local $pc i32)\n")
+	fmt.Fprintf(bw, "    (local $a i32)\n")
+	fmt.Fprintf(bw, "    (local $b i32)\n")
+	fmt.Fprintf(bw, "    (local $c i32)\n")
+	fmt.Fprintf(bw, "    i32.const %d\n", opts.Entry)
+	fmt.Fprintf(bw, "    local.set $pc\n")
+	fmt.Fprintf(bw, "    loop $dispatch\n")
+	fmt.Fprintf(bw, "    block $default\n")
+	for i := len(code) - 1; i >= 0; i-- {
+		fmt.Fprintf(bw, "    block $i%x\n", code[i].Addr)
+	}
+
+	// One br_table entry per image word; words that do not start a
+	// translated instruction go to the default case
+	cases := make(map[int32]bool, len(code))
+	for _, in := range code {
+		cases[in.Addr] = true
+	}
+	fmt.Fprintf(bw, "      local.get $pc\n")
+	fmt.Fprintf(bw, "      call $index\n")
+	fmt.Fprintf(bw, "      br_table")
+	for i := range image {
+		addr := int32(i * 4)
+		if cases[addr] {
+			fmt.Fprintf(bw, " $i%x", addr)
+		} else {
+			fmt.Fprintf(bw, " $default")
+		}
+	}
+	fmt.Fprintf(bw, " $default\n")
+
+	for i, in := range code {
+		fmt.Fprintf(bw, "    end\n")
+		writeWATCase(bw, in, i+1 < len(code) && code[i+1].Addr == in.Next())
+	}
+
+	fmt.Fprintf(bw, "    end\n")
+	fmt.Fprintf(bw, "      i32.const %d\n", watFaultUntranslated)
+	fmt.Fprintf(bw, "      call $fail\n")
+	fmt.Fprintf(bw, "    end\n")
+	fmt.Fprintf(bw, "    unreachable)\n")
+	fmt.Fprintf(bw, ")\n")
+
+	return bw.Flush()
+}
+
+// writeWATData writes the image as a data segment, each word at four times
+// its VM address
+func writeWATData(w io.Writer, image []int32) {
+	if len(image) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "  (data (i32.const 0) \"")
+	for i, word := range image {
+		if i > 0 {
+			fmt.Fprintf(w, "%s", strings.Repeat("\\00", 12))
+		}
+		u := uint32(word)
+		fmt.Fprintf(w, "\\%02x\\%02x\\%02x\\%02x", byte(u), byte(u>>8), byte(u>>16), byte(u>>24))
+	}
+	fmt.Fprintf(w, "\")\n")
+}
+
+// writeWATCase writes the code for one instruction. If the next case is
+// the following instruction, control falls through to it.
+func writeWATCase(w io.Writer, in vm.Instruction, fallThrough bool) {
+	fmt.Fprintf(w, "      ;; %#x %s", in.Addr, in.Op)
+	if in.Op.HasOperand() {
+		fmt.Fprintf(w, " %#x", in.Operand)
+	}
+	fmt.Fprintf(w, "\n")
+
+	emit := func(code string) {
+		for _, line := range strings.Split(code, "\n") {
+			if line != "" {
+				fmt.Fprintf(w, "      %s\n", line)
+			}
+		}
+	}
+	jump := func() {
+		emit("local.set $pc\nbr $dispatch")
+	}
+
+	if code, ok := watStraight[in.Op]; ok {
+		if in.Op.HasOperand() {
+			code = fmt.Sprintf(code, in.Operand)
+		}
+		emit(code)
+		if !fallThrough {
+			emit(fmt.Sprintf("i32.const %d", in.Next()))
+			jump()
+		}
+		return
+	}
+
+	switch in.Op {
+	case vm.JMP:
+		// Jumping to the current address halts the program
+		emit(fmt.Sprintf("call $pop\ncall $check\nlocal.tee $a\ni32.const %d\ni32.eq\nif\n  i32.const 0\n  return\nend\nlocal.get $a", in.Addr))
+		jump()
+	case vm.JZ, vm.JNZ:
+		emit("call $pop\nlocal.set $a\ncall $pop\nlocal.set $b\nlocal.get $a")
+		if in.Op == vm.JZ {
+			emit("i32.eqz")
+		}
+		emit("if\n  local.get $b\n  call $check\n  local.set $pc\n  br $dispatch\nend")
+		if !fallThrough {
+			emit(fmt.Sprintf("i32.const %d", in.Next()))
+			jump()
+		}
+	case vm.POPIP, vm.RET:
+		emit("call $popip\ncall $check")
+		jump()
+	case vm.CALL:
+		emit(fmt.Sprintf("i32.const %d\ncall $puship\ni32.const %d\ncall $check", in.Next(), in.Operand))
+		jump()
+	case vm.CALLS:
+		emit(fmt.Sprintf("call $pop\ncall $check\ni32.const %d\ncall $puship", in.Next()))
+		jump()
+	case vm.THROW:
+		emit("call $pop\ncall $throw")
+		jump()
+	case vm.EXIT:
+		emit("call $pop\nreturn")
+	default:
+		emit("i32.const -4\ncall $fail")
+	}
+}
@@ -0,0 +1,84 @@
+package transpile
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenWAT returns the golden file of the module compiled from a program
+func goldenWAT(file string) string {
+	return filepath.Join("testdata", strings.TrimSuffix(filepath.Base(file), ".src")+".wat")
+}
+
+// TestWATGolden compares the module written for every example program with
+// its golden file. Run with -update to rewrite the golden files.
+func TestWATGolden(t *testing.T) {
+	files, err := filepath.Glob("../../programs/*.src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m := loadImage(t, compileSource(t, file, string(src)), new(bytes.Buffer))
+
+			var got bytes.Buffer
+			opts := Options{Name: filepath.Base(file), Entry: m.Entry()}
+			if err := WAT(&got, m.Image(), opts); err != nil {
+				t.Fatal(err)
+			}
+
+			golden := goldenWAT(file)
+			if *update {
+				if err := os.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v; run go test -update to write it", err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("module differs from %s; run go test -update after checking the change", golden)
+			}
+		})
+	}
+}
+
+// TestWATValidates checks that the golden modules are valid WebAssembly
+// with wat2wasm from the WebAssembly Binary Toolkit
+func TestWATValidates(t *testing.T) {
+	wat2wasm, err := exec.LookPath("wat2wasm")
+	if err != nil {
+		t.Skip("wat2wasm not found")
+	}
+
+	files, err := filepath.Glob(filepath.Join("testdata", "*.wat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden modules in testdata")
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "module.wasm")
+			if msg, err := exec.Command(wat2wasm, file, "-o", out).CombinedOutput(); err != nil {
+				t.Errorf("%v\n%s", err, msg)
+			}
+		})
+	}
+}
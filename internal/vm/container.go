@@ -0,0 +1,125 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Container is the layout shared by images and object files: a fixed
+// header followed by sections, each a header and a payload. All fields are
+// little-endian.
+type Container struct {
+	Magic    string // ImageMagic, or the magic of object files
+	Version  uint16
+	WordSize uint16
+	Entry    int32
+	Sections []ContainerSection
+}
+
+// ContainerSection is a section of a container
+type ContainerSection struct {
+	Kind    SectionKind
+	Addr    int32 // Load address in images, alignment in object files
+	Payload []byte
+}
+
+// containerHeader is the fixed part of a container
+type containerHeader struct {
+	Magic    [4]byte
+	Version  uint16
+	WordSize uint16
+	Entry    int32
+	Sections uint32
+}
+
+// sectionHeader precedes the payload of each section
+type sectionHeader struct {
+	Kind SectionKind
+	Addr int32
+	Size uint32 // Payload size in bytes
+}
+
+// AlignUp rounds addr up to a multiple of align
+func AlignUp(addr, align int32) int32 {
+	return (addr + align - 1) / align * align
+}
+
+// ReadContainer splits data in the container layout into its header and
+// sections. The caller checks the magic, version and word size.
+func ReadContainer(data []byte) (*Container, error) {
+	r := bytes.NewReader(data)
+
+	var h containerHeader
+	if err := binary.Read(r, binary.LittleEndian, &h); err != nil {
+		return nil, fmt.Errorf("truncated header")
+	}
+	c := &Container{
+		Magic:    string(h.Magic[:]),
+		Version:  h.Version,
+		WordSize: h.WordSize,
+		Entry:    h.Entry,
+	}
+
+	for i := uint32(0); i < h.Sections; i++ {
+		var sh sectionHeader
+		if err := binary.Read(r, binary.LittleEndian, &sh); err != nil {
+			return nil, fmt.Errorf("truncated section header")
+		}
+		if uint64(sh.Size) > uint64(r.Len()) {
+			return nil, fmt.Errorf("truncated %s section", sh.Kind)
+		}
+		payload := make([]byte, sh.Size)
+		r.Read(payload)
+		c.Sections = append(c.Sections, ContainerSection{Kind: sh.Kind, Addr: sh.Addr, Payload: payload})
+	}
+	return c, nil
+}
+
+// WriteContainer writes a container
+func WriteContainer(w io.Writer, c *Container) error {
+	h := containerHeader{
+		Version:  c.Version,
+		WordSize: c.WordSize,
+		Entry:    c.Entry,
+		Sections: uint32(len(c.Sections)),
+	}
+	copy(h.Magic[:], c.Magic)
+
+	bw := new(bytes.Buffer)
+	binary.Write(bw, binary.LittleEndian, h)
+	for _, s := range c.Sections {
+		sh := sectionHeader{Kind: s.Kind, Addr: s.Addr, Size: uint32(len(s.Payload))}
+		binary.Write(bw, binary.LittleEndian, sh)
+		bw.Write(s.Payload)
+	}
+
+	_, err := w.Write(bw.Bytes())
+	return err
+}
+
+// WriteName writes a label name preceded by its 16-bit length, as symbol
+// sections store names
+func WriteName(w *bytes.Buffer, name string) error {
+	if len(name) > 0xffff {
+		return fmt.Errorf("label name too long: %.20s...", name)
+	}
+	binary.Write(w, binary.LittleEndian, uint16(len(name)))
+	w.WriteString(name)
+	return nil
+}
+
+// ReadName reads a label name preceded by its 16-bit length
+func ReadName(r *bytes.Reader) (string, error) {
+	var n uint16
+	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		return "", err
+	}
+	if int(n) > r.Len() {
+		return "", io.ErrUnexpectedEOF
+	}
+	name := make([]byte, n)
+	r.Read(name)
+	return string(name), nil
+}
@@ -0,0 +1,111 @@
+package vm
+
+import (
+	"sort"
+)
+
+// Instruction is an instruction decoded from a program image
+type Instruction struct {
+	Addr    int32 // Address of the opcode word
+	Op      Op    // Opcode
+	Operand int32 // Immediate word, if the opcode has one
+}
+
+// Size returns the size of the instruction in bytes
+func (in Instruction) Size() int32 {
+	if in.Op.HasOperand() {
+		return 8
+	}
+	return 4
+}
+
+// Next returns the address of the following instruction
+func (in Instruction) Next() int32 {
+	return in.Addr + in.Size()
+}
+
+// FallsThrough reports whether execution can continue with the following
+// instruction
+func (in Instruction) FallsThrough() bool {
+	switch in.Op {
+	case JMP, POPIP, RET, THROW, EXIT:
+		return false
+	}
+	return true
+}
+
+// Image returns the program words from address zero up to Size, one word
+// per 4-byte address
+func (m *VM) Image() []int32 {
+	size := m.Size()
+	image := make([]int32, 0, size/4)
+	for addr := int32(0); addr < size; addr += 4 {
+		image = append(image, m.memory[addr])
+	}
+	return image
+}
+
+// IsCodeAddress reports whether addr is a word address inside image
+func IsCodeAddress(image []int32, addr int32) bool {
+	return addr >= 0 && addr%4 == 0 && int(addr/4) < len(image)
+}
+
+// Decode decodes the instruction at addr. It returns false if addr is
+// outside the image or the instruction's immediate word is missing.
+func Decode(image []int32, addr int32) (Instruction, bool) {
+	if !IsCodeAddress(image, addr) {
+		return Instruction{}, false
+	}
+
+	in := Instruction{Addr: addr, Op: Op(image[addr/4])}
+	if in.Op.HasOperand() {
+		if !IsCodeAddress(image, addr+4) {
+			return in, false
+		}
+		in.Operand = image[addr/4+1]
+	}
+
+	return in, true
+}
+
+// Reachable decodes every instruction reachable from entry. Besides
+// fall-through and return addresses, any immediate of PUSH, PUSHIP, TRY or
+// CALL that is a word address in the image is treated as a possible
+// target, since it may be jumped to later. The result is sorted by address.
+func Reachable(image []int32, entry int32) []Instruction {
+	seen := make(map[int32]bool)
+	var result []Instruction
+
+	work := []int32{entry}
+	for len(work) > 0 {
+		addr := work[len(work)-1]
+		work = work[:len(work)-1]
+
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+
+		in, ok := Decode(image, addr)
+		if !ok {
+			continue
+		}
+		result = append(result, in)
+
+		switch in.Op {
+		case PUSH, PUSHIP, TRY, CALL:
+			if IsCodeAddress(image, in.Operand) {
+				work = append(work, in.Operand)
+			}
+		}
+
+		if in.FallsThrough() {
+			work = append(work, in.Next())
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Addr < result[j].Addr
+	})
+	return result
+}
@@ -0,0 +1,91 @@
+package vm
+
+import (
+	"fmt"
+)
+
+// Fault codes pushed to a handler when a trapped runtime fault is thrown
+const (
+	FaultStackUnderflow   int32 = -1 // POP on empty data stack
+	FaultIPStackUnderflow int32 = -2 // POP on empty IP stack
+	FaultOutOfBounds      int32 = -3 // memory access or jump outside memory
+	FaultUnknownOp        int32 = -4 // unknown instruction
+	FaultFrame            int32 = -5 // LEAVE without frame or bad local slot
+	FaultExitStatus       int32 = -6 // EXIT with the status reserved for faults
+)
+
+// FaultStatus is the exit status of the smg process after a runtime fault.
+// EXIT with a status equal to it modulo 256 is a fault too, so that the
+// process status always tells a fault from a status the program chose.
+const FaultStatus = 70
+
+// ProcessStatus returns the exit status of the smg process for the status a
+// program passed to EXIT. The operating system keeps only the low 8 bits,
+// so a nonzero status whose low 8 bits are zero becomes 1 rather than
+// looking like success.
+func ProcessStatus(status int) int {
+	if status != 0 && status&0xff == 0 {
+		return 1
+	}
+	return status & 0xff
+}
+
+// Handler represents an active TRY block
+type Handler struct {
+	Addr    int32 // Handler address
+	StackSP int   // Data stack depth at TRY time
+	IPSP    int   // IP stack depth at TRY time
+	FrameSP int   // Frame stack depth at TRY time
+	FP      int32 // Frame pointer at TRY time
+}
+
+// SetTrapFaults controls whether runtime faults become catchable throws
+func (m *VM) SetTrapFaults(trap bool) {
+	m.trapFaults = trap
+}
+
+// Fault reports a runtime fault. If faults are trapped and a handler is
+// active, the fault is thrown with the given code once the current
+// instruction completes; otherwise it is reported as an error.
+func (m *VM) Fault(code int32, msg string) {
+	if m.trapFaults && len(m.handlers) > 0 {
+		if !m.faultPending {
+			m.faultPending = true
+			m.faultCode = code
+		}
+		return
+	}
+	m.Error(msg)
+}
+
+// Throw unwinds to the innermost handler and pushes code for it
+func (m *VM) Throw(code int32) {
+	if len(m.handlers) == 0 {
+		m.Error(fmt.Sprintf("uncaught THROW %d", code))
+		m.running = false
+		return
+	}
+
+	h := m.handlers[len(m.handlers)-1]
+	m.handlers = m.handlers[:len(m.handlers)-1]
+
+	if len(m.stack) > h.StackSP {
+		m.stack = m.stack[:h.StackSP]
+	}
+	if len(m.stackIP) > h.IPSP {
+		m.stackIP = m.stackIP[:h.IPSP]
+	}
+	if len(m.frames) > h.FrameSP {
+		m.frames = m.frames[:h.FrameSP]
+	}
+	m.fp = h.FP
+
+	if h.Addr < 0 || int(h.Addr) >= m.memSize {
+		m.Error("THROW handler out of bounds")
+		m.running = false
+		return
+	}
+
+	m.Push(code)
+	m.ip = h.Addr
+}
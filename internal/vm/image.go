@@ -0,0 +1,257 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ImageMagic starts every image in the container format. Read as a word it
+// is not a valid opcode, so it cannot begin a headerless image.
+const ImageMagic = "SMGI"
+
+// ImageVersion is the container format version written by SaveImage
+const ImageVersion = 1
+
+// SectionKind identifies the contents of an image section
+type SectionKind uint32
+
+// Image section kinds
+const (
+	SectionCode    SectionKind = 1 // Instruction words loaded at the section address
+	SectionData    SectionKind = 2 // Data words loaded at the section address
+	SectionSymbols SectionKind = 3 // Label names and addresses
+	SectionDebug   SectionKind = 4 // Line table in the format of WriteLineTable
+	SectionBSS     SectionKind = 5 // Zero-filled space; the payload is its size in bytes
+	SectionRelocs  SectionKind = 6 // Relocation records of an object file
+)
+
+// String returns the name of a section kind
+func (k SectionKind) String() string {
+	switch k {
+	case SectionCode:
+		return "code"
+	case SectionData:
+		return "data"
+	case SectionSymbols:
+		return "symbols"
+	case SectionDebug:
+		return "debug"
+	case SectionBSS:
+		return "bss"
+	case SectionRelocs:
+		return "relocations"
+	}
+	return fmt.Sprintf("section %d", uint32(k))
+}
+
+// Region is a range of memory holding code, data or zero-filled space
+type Region struct {
+	Kind SectionKind // SectionCode, SectionData or SectionBSS
+	Addr int32
+	Size int32 // Size in bytes
+}
+
+// End returns the address following the region
+func (r Region) End() int32 {
+	return r.Addr + r.Size
+}
+
+// Regions returns the code, data and bss sections of the program in address
+// order. It is empty for programs that are a single run of code from
+// address zero, such as headerless images.
+func (m *VM) Regions() []Region {
+	return m.regions
+}
+
+// SetRegions sets the sections the program is saved as
+func (m *VM) SetRegions(regions []Region) {
+	m.regions = regions
+}
+
+// Entry returns the address execution starts at
+func (m *VM) Entry() int32 {
+	return m.entry
+}
+
+// SetEntry sets the address execution starts at
+func (m *VM) SetEntry(addr int32) {
+	m.entry = addr
+}
+
+// Labels returns the program's labels
+func (m *VM) Labels() []Label {
+	return m.labels
+}
+
+// SetLabels replaces the program's labels
+func (m *VM) SetLabels(labels []Label) {
+	m.labels = labels
+}
+
+// Debug returns the debug section loaded with the image
+func (m *VM) Debug() []byte {
+	return m.debug
+}
+
+// SetDebug sets the debug section saved with the image, a line table in
+// the format of WriteLineTable
+func (m *VM) SetDebug(debug []byte) {
+	m.debug = debug
+}
+
+// ImageFormat returns the container version of the loaded image, or zero
+// for a legacy headerless image
+func (m *VM) ImageFormat() int {
+	return m.format
+}
+
+// loadContainer loads an image in the container format
+func (m *VM) loadContainer(data []byte) error {
+	c, err := ReadContainer(data)
+	if err != nil {
+		return fmt.Errorf("image: %v", err)
+	}
+	if c.Version != ImageVersion {
+		return fmt.Errorf("unsupported image version %d", c.Version)
+	}
+	if c.WordSize != uint16(m.WordSize()) {
+		return fmt.Errorf("unsupported word size %d", c.WordSize)
+	}
+	if c.Entry < 0 || c.Entry%4 != 0 || int(c.Entry) >= m.memSize {
+		return fmt.Errorf("entry 0x%x is not a word address in memory", c.Entry)
+	}
+
+	for _, sec := range c.Sections {
+		switch sec.Kind {
+		case SectionCode, SectionData:
+			if err := m.loadWords(sec); err != nil {
+				return err
+			}
+			m.regions = append(m.regions, Region{Kind: sec.Kind, Addr: sec.Addr, Size: int32(len(sec.Payload))})
+		case SectionBSS:
+			region, err := m.loadBSS(sec)
+			if err != nil {
+				return err
+			}
+			m.regions = append(m.regions, region)
+		case SectionSymbols:
+			if err := m.loadSymbols(sec.Payload); err != nil {
+				return err
+			}
+		case SectionDebug:
+			lines, err := ReadLineTable(bytes.NewReader(sec.Payload))
+			if err != nil {
+				return fmt.Errorf("debug section: %v", err)
+			}
+			m.debug = sec.Payload
+			m.lines = lines
+		}
+		// Unknown sections are skipped so that newer files still load
+	}
+
+	sort.SliceStable(m.regions, func(i, j int) bool {
+		return m.regions[i].Addr < m.regions[j].Addr
+	})
+	m.entry = c.Entry
+	m.format = int(c.Version)
+	return nil
+}
+
+// loadWords copies a code or data section into memory
+func (m *VM) loadWords(sec ContainerSection) error {
+	if len(sec.Payload)%4 != 0 {
+		return fmt.Errorf("%s section size %d is not a multiple of the word size", sec.Kind, len(sec.Payload))
+	}
+
+	words := int64(len(sec.Payload) / 4)
+	if sec.Addr < 0 || sec.Addr%4 != 0 || int64(sec.Addr)+words*4 > int64(m.memSize) {
+		return fmt.Errorf("%s section at 0x%x does not fit in memory", sec.Kind, sec.Addr)
+	}
+
+	for i := int64(0); i < words; i++ {
+		m.memory[int64(sec.Addr)+i*4] = int32(binary.LittleEndian.Uint32(sec.Payload[i*4:]))
+	}
+	return nil
+}
+
+// loadBSS checks that a bss section fits in memory. Memory is already
+// zero-filled, so nothing needs to be loaded.
+func (m *VM) loadBSS(sec ContainerSection) (Region, error) {
+	if len(sec.Payload) != 4 {
+		return Region{}, fmt.Errorf("bss section payload must be 4 bytes")
+	}
+
+	size := int64(binary.LittleEndian.Uint32(sec.Payload))
+	if sec.Addr < 0 || sec.Addr%4 != 0 || size%4 != 0 || int64(sec.Addr)+size > int64(m.memSize) {
+		return Region{}, fmt.Errorf("bss section at 0x%x does not fit in memory", sec.Addr)
+	}
+	return Region{Kind: SectionBSS, Addr: sec.Addr, Size: int32(size)}, nil
+}
+
+// loadSymbols reads a symbol section: per label, its address, the length
+// of its name and the name
+func (m *VM) loadSymbols(payload []byte) error {
+	r := bytes.NewReader(payload)
+	for r.Len() > 0 {
+		var addr int32
+		if err := binary.Read(r, binary.LittleEndian, &addr); err != nil {
+			return fmt.Errorf("truncated symbol section")
+		}
+		name, err := ReadName(r)
+		if err != nil {
+			return fmt.Errorf("truncated symbol section")
+		}
+		m.AddLabel(name, addr)
+	}
+	return nil
+}
+
+// saveContainer writes the program in the container format
+func (m *VM) saveContainer(w io.Writer) error {
+	c := &Container{
+		Magic:    ImageMagic,
+		Version:  ImageVersion,
+		WordSize: uint16(m.WordSize()),
+		Entry:    m.entry,
+	}
+
+	if len(m.regions) == 0 {
+		code := new(bytes.Buffer)
+		for _, word := range m.Image() {
+			binary.Write(code, binary.LittleEndian, word)
+		}
+		c.Sections = append(c.Sections, ContainerSection{Kind: SectionCode, Payload: code.Bytes()})
+	}
+
+	for _, r := range m.regions {
+		payload := new(bytes.Buffer)
+		if r.Kind == SectionBSS {
+			binary.Write(payload, binary.LittleEndian, uint32(r.Size))
+		} else {
+			for addr := r.Addr; addr < r.End(); addr += 4 {
+				binary.Write(payload, binary.LittleEndian, m.memory[addr])
+			}
+		}
+		c.Sections = append(c.Sections, ContainerSection{Kind: r.Kind, Addr: r.Addr, Payload: payload.Bytes()})
+	}
+
+	if len(m.labels) > 0 {
+		syms := new(bytes.Buffer)
+		for _, label := range m.labels {
+			binary.Write(syms, binary.LittleEndian, label.Pos)
+			if err := WriteName(syms, label.Name); err != nil {
+				return err
+			}
+		}
+		c.Sections = append(c.Sections, ContainerSection{Kind: SectionSymbols, Payload: syms.Bytes()})
+	}
+
+	if len(m.debug) > 0 {
+		c.Sections = append(c.Sections, ContainerSection{Kind: SectionDebug, Payload: m.debug})
+	}
+
+	return WriteContainer(w, c)
+}
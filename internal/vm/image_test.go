@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+)
+
+// containerWithEntry returns an image whose code is a halt and whose
+// header gives entry
+func containerWithEntry(entry int32) []byte {
+	code := new(bytes.Buffer)
+	binary.Write(code, binary.LittleEndian, []int32{int32(PUSH), 8, int32(JMP)})
+
+	var image bytes.Buffer
+	WriteContainer(&image, &Container{
+		Magic:    ImageMagic,
+		Version:  ImageVersion,
+		WordSize: 4,
+		Entry:    entry,
+		Sections: []ContainerSection{{Kind: SectionCode, Payload: code.Bytes()}},
+	})
+	return image.Bytes()
+}
+
+// TestLoadImageEntry checks that images with an entry outside memory are
+// rejected when they are loaded, not when they are run
+func TestLoadImageEntry(t *testing.T) {
+	tests := []struct {
+		entry int32
+		ok    bool
+	}{
+		{0, true},
+		{8, true},
+		{1024 - 4, true},
+		{1024, false},
+		{0x7ffffff0, false},
+		{-4, false},
+		{2, false},
+	}
+	for _, tt := range tests {
+		m := NewMachineWithSize(1024, io.Discard, strings.NewReader(""), func(msg string) {
+			t.Fatal(msg)
+		})
+		err := m.LoadImage(bytes.NewReader(containerWithEntry(tt.entry)))
+		if tt.ok && err != nil {
+			t.Errorf("entry 0x%x: %v", tt.entry, err)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("entry 0x%x: image loaded", tt.entry)
+		}
+	}
+}
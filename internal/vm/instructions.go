@@ -33,6 +33,17 @@ const (
 	POPIP      // pop IP stack to current IP, effectively performing a jump
 	DROPIP     // pop IP, but do not jump
 	COMPL      // pop a, push the complement of a
+	TRY        // push next word as exception handler address
+	ENDTRY     // remove the innermost exception handler
+	THROW      // pop a, unwind to the innermost handler and push a
+	ENTER      // open a frame with next word local slots
+	LEAVE      // close the current frame
+	LOADL      // push local slot given by next word
+	STORL      // pop a, write a to local slot given by next word
+	CALL       // push return address in IP stack, goto next word
+	CALLS      // pop a, push return address in IP stack, goto a
+	RET        // pop IP stack to current IP, returning to the caller
+	EXIT       // pop a, stop the machine with exit status a
 	NOP_END    // placeholder for end of enum; MUST BE LAST
 )
 
@@ -62,6 +73,17 @@ var OpStr = []string{
 	"POPIP",
 	"DROPIP",
 	"COMPL",
+	"TRY",
+	"ENDTRY",
+	"THROW",
+	"ENTER",
+	"LEAVE",
+	"LOADL",
+	"STORL",
+	"CALL",
+	"CALLS",
+	"RET",
+	"EXIT",
 	"NOP_END",
 }
 
@@ -73,6 +95,62 @@ func (op Op) String() string {
 	return "<?>"
 }
 
+// HasOperand reports whether the opcode is followed by an immediate word
+func (op Op) HasOperand() bool {
+	switch op {
+	case PUSH, PUSHIP, TRY, ENTER, LOADL, STORL, CALL:
+		return true
+	}
+	return false
+}
+
+// stackEffects gives the number of words each instruction pops from and
+// pushes to the data stack
+var stackEffects = map[Op][2]int{
+	NOP:    {0, 0},
+	ADD:    {2, 1},
+	SUB:    {2, 1},
+	AND:    {2, 1},
+	OR:     {2, 1},
+	XOR:    {2, 1},
+	NOT:    {1, 1},
+	IN:     {0, 1},
+	OUT:    {1, 0},
+	LOAD:   {1, 1},
+	STOR:   {2, 0},
+	JMP:    {1, 0},
+	JZ:     {2, 0},
+	PUSH:   {0, 1},
+	DUP:    {1, 2},
+	SWAP:   {2, 2},
+	ROL3:   {3, 3},
+	OUTNUM: {1, 0},
+	JNZ:    {2, 0},
+	DROP:   {1, 0},
+	PUSHIP: {0, 0},
+	POPIP:  {0, 0},
+	DROPIP: {0, 0},
+	COMPL:  {1, 1},
+	TRY:    {0, 0},
+	ENDTRY: {0, 0},
+	THROW:  {1, 0},
+	ENTER:  {0, 0},
+	LEAVE:  {0, 0},
+	LOADL:  {0, 1},
+	STORL:  {1, 0},
+	CALL:   {0, 0},
+	CALLS:  {1, 0},
+	RET:    {0, 0},
+	EXIT:   {1, 0},
+}
+
+// StackEffect returns the number of words the instruction pops from and
+// pushes to the data stack. THROW's push happens at the handler.
+func (op Op) StackEffect() (pops, pushes int) {
+	effect := stackEffects[op]
+	return effect[0], effect[1]
+}
+
 // FromString converts a string to an opcode
 func FromString(s string) Op {
 	upper := strings.ToUpper(s)
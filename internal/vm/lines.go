@@ -0,0 +1,144 @@
+package vm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SourceLine maps the instructions from Addr up to the next entry of a line
+// table to a line of source code
+type SourceLine struct {
+	Addr int32
+	File string
+	Line int
+}
+
+// SetLines sets the line table, sorted by address
+func (m *VM) SetLines(lines []SourceLine) {
+	m.lines = lines
+}
+
+// Lines returns the line table
+func (m *VM) Lines() []SourceLine {
+	return m.lines
+}
+
+// InstrAddr returns the address of the instruction being executed
+func (m *VM) InstrAddr() int32 {
+	return m.instr
+}
+
+// Location describes the source position of the instruction at addr as
+// "file:line in label", naming the closest label at or before addr. It
+// returns an empty string if there is no line table entry for addr.
+func (m *VM) Location(addr int32) string {
+	i := sort.Search(len(m.lines), func(i int) bool {
+		return m.lines[i].Addr > addr
+	}) - 1
+	if i < 0 {
+		return ""
+	}
+
+	loc := fmt.Sprintf("%s:%d", m.lines[i].File, m.lines[i].Line)
+
+	var best *Label
+	for j := range m.labels {
+		label := &m.labels[j]
+		if label.Pos <= addr && (best == nil || label.Pos > best.Pos) {
+			best = label
+		}
+	}
+	if best != nil {
+		loc += " in " + best.Name
+	}
+	return loc
+}
+
+// WriteLineTable writes a line table as text, one "address file:line" entry
+// per line. The same format is used for debug sections and .map files.
+func WriteLineTable(w io.Writer, lines []SourceLine) error {
+	bw := bufio.NewWriter(w)
+	for _, l := range lines {
+		fmt.Fprintf(bw, "0x%x %s:%d\n", l.Addr, l.File, l.Line)
+	}
+	return bw.Flush()
+}
+
+// WriteMap writes a map file: the labels, one "address label:" entry per
+// line, followed by the line table
+func WriteMap(w io.Writer, labels []Label, lines []SourceLine) error {
+	bw := bufio.NewWriter(w)
+	for _, l := range labels {
+		fmt.Fprintf(bw, "0x%x %s:\n", l.Pos, l.Name)
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return WriteLineTable(w, lines)
+}
+
+// ReadLineTable reads a line table written by WriteLineTable
+func ReadLineTable(r io.Reader) ([]SourceLine, error) {
+	_, lines, err := ReadMap(r)
+	return lines, err
+}
+
+// ReadMap reads a map file written by WriteMap, returning its labels and
+// its line table sorted by address
+func ReadMap(r io.Reader) ([]Label, []SourceLine, error) {
+	var labels []Label
+	var lines []SourceLine
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		fields := strings.SplitN(text, " ", 2)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("map entry %d: expected address and position", n)
+		}
+		addr, err := strconv.ParseInt(fields[0], 0, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("map entry %d: bad address %s", n, fields[0])
+		}
+
+		if strings.HasSuffix(fields[1], ":") {
+			labels = append(labels, NewLabel(strings.TrimSuffix(fields[1], ":"), int32(addr)))
+			continue
+		}
+
+		colon := strings.LastIndex(fields[1], ":")
+		if colon < 0 {
+			return nil, nil, fmt.Errorf("map entry %d: expected file:line or label:", n)
+		}
+		line, err := strconv.Atoi(fields[1][colon+1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("map entry %d: bad line number", n)
+		}
+
+		lines = append(lines, SourceLine{Addr: int32(addr), File: fields[1][:colon], Line: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Addr < lines[j].Addr
+	})
+	return labels, lines, nil
+}
+
+// EncodeLines returns a line table in the form stored in debug sections
+func EncodeLines(lines []SourceLine) []byte {
+	buf := new(bytes.Buffer)
+	WriteLineTable(buf, lines)
+	return buf.Bytes()
+}
@@ -0,0 +1,166 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// InputEvent is one result of an IN instruction
+type InputEvent struct {
+	Step uint64 // Step at which the byte was read
+	Byte byte   // Byte read, unless EOF
+	EOF  bool   // Input was exhausted
+}
+
+// String formats the event as a line of an input log
+func (e InputEvent) String() string {
+	if e.EOF {
+		return fmt.Sprintf("%d eof", e.Step)
+	}
+	return fmt.Sprintf("%d %d", e.Step, e.Byte)
+}
+
+// ParseInputEvent parses a line of an input log
+func ParseInputEvent(line string) (InputEvent, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return InputEvent{}, fmt.Errorf("malformed input event: %q", line)
+	}
+
+	step, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return InputEvent{}, fmt.Errorf("malformed step in input event: %q", line)
+	}
+
+	if fields[1] == "eof" {
+		return InputEvent{Step: step, EOF: true}, nil
+	}
+
+	b, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil {
+		return InputEvent{}, fmt.Errorf("malformed byte in input event: %q", line)
+	}
+
+	return InputEvent{Step: step, Byte: byte(b)}, nil
+}
+
+// InputRecorder is an input stream that passes bytes through from another
+// reader and logs each one, including EOF, with the step of the machine
+// that read it
+type InputRecorder struct {
+	m   *VM
+	in  io.ByteReader
+	log io.Writer
+}
+
+// NewInputRecorder creates a recorder reading from in and logging to log.
+// Attach it to the machine with SetInput.
+func NewInputRecorder(m *VM, in io.Reader, log io.Writer) *InputRecorder {
+	return &InputRecorder{
+		m:   m,
+		in:  byteReader(in),
+		log: log,
+	}
+}
+
+// ReadByte reads and logs one byte
+func (r *InputRecorder) ReadByte() (byte, error) {
+	b, err := r.in.ReadByte()
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+
+	event := InputEvent{Step: r.m.Steps(), Byte: b, EOF: err == io.EOF}
+	if _, werr := fmt.Fprintln(r.log, event); werr != nil {
+		return 0, werr
+	}
+
+	return b, err
+}
+
+// Read reads and logs at most one byte
+func (r *InputRecorder) Read(p []byte) (int, error) {
+	return readOne(r, p)
+}
+
+// InputReplayer is an input stream that feeds back the bytes of an input
+// log, failing if the machine reads at a different step than recorded
+type InputReplayer struct {
+	m      *VM
+	events []InputEvent
+	next   int
+}
+
+// NewInputReplayer creates a replayer from an input log. Attach it to the
+// machine with SetInput.
+func NewInputReplayer(m *VM, log io.Reader) (*InputReplayer, error) {
+	r := &InputReplayer{m: m}
+
+	scanner := bufio.NewScanner(log)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		event, err := ParseInputEvent(line)
+		if err != nil {
+			return nil, err
+		}
+		r.events = append(r.events, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ReadByte returns the next recorded byte
+func (r *InputReplayer) ReadByte() (byte, error) {
+	step := r.m.Steps()
+
+	if r.next >= len(r.events) {
+		return 0, fmt.Errorf("replay diverged: read at step %d after the end of the log", step)
+	}
+
+	event := r.events[r.next]
+	if event.Step != step {
+		return 0, fmt.Errorf("replay diverged: read at step %d, recorded at step %d", step, event.Step)
+	}
+	r.next++
+
+	if event.EOF {
+		return 0, io.EOF
+	}
+	return event.Byte, nil
+}
+
+// Read returns at most one recorded byte
+func (r *InputReplayer) Read(p []byte) (int, error) {
+	return readOne(r, p)
+}
+
+// Remaining returns the number of recorded events not yet read
+func (r *InputReplayer) Remaining() int {
+	return len(r.events) - r.next
+}
+
+// readOne fills p with a single byte from br
+func readOne(br io.ByteReader, p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	b, err := br.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+
+	p[0] = b
+	return 1, nil
+}
@@ -0,0 +1,518 @@
+package vm
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Problem is a defect found by Verify
+type Problem struct {
+	Addr int32  // Address of the offending instruction
+	Msg  string // Description of the defect
+}
+
+// String returns the problem as "address: message"
+func (p Problem) String() string {
+	return fmt.Sprintf("0x%x: %s", p.Addr, p.Msg)
+}
+
+// Report is the result of verifying a program image
+type Report struct {
+	Problems     []Problem // Defects, sorted by address
+	Instructions int       // Number of instructions reachable from the entry point
+	MaxDepth     int       // Worst-case data stack depth, a lower bound unless Static
+	Static       bool      // Whether the stack depth is known at every instruction
+	Targets      []int32   // Constant control transfer targets inside the image, sorted
+	DataRefs     []int32   // Constant LOAD and STOR addresses inside the image, sorted
+}
+
+// OK reports whether verification found no problems
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// absValue is a stack word during verification; only constants are tracked
+type absValue struct {
+	known bool
+	n     int32
+}
+
+// absStack models a stack during verification. If floor is set, the stack
+// may hold any number of unknown words below vals.
+type absStack struct {
+	floor bool
+	vals  []absValue
+}
+
+func (s absStack) clone() absStack {
+	return absStack{floor: s.floor, vals: append([]absValue(nil), s.vals...)}
+}
+
+// pop removes the top word. It returns false on a certain underflow.
+func (s *absStack) pop() (absValue, bool) {
+	if len(s.vals) == 0 {
+		return absValue{}, s.floor
+	}
+	v := s.vals[len(s.vals)-1]
+	s.vals = s.vals[:len(s.vals)-1]
+	return v, true
+}
+
+func (s *absStack) push(v absValue) {
+	s.vals = append(s.vals, v)
+}
+
+// merge combines two stacks reaching the same address. Stacks of different
+// depth keep only the words they agree on at the top.
+func (s absStack) merge(o absStack) absStack {
+	floor := s.floor || o.floor || len(s.vals) != len(o.vals)
+	n := len(s.vals)
+	if len(o.vals) < n {
+		n = len(o.vals)
+	}
+
+	vals := make([]absValue, n)
+	for i := 1; i <= n; i++ {
+		a, b := s.vals[len(s.vals)-i], o.vals[len(o.vals)-i]
+		if a == b {
+			vals[n-i] = a
+		}
+	}
+	return absStack{floor: floor, vals: vals}
+}
+
+func (s absStack) equal(o absStack) bool {
+	if s.floor != o.floor || len(s.vals) != len(o.vals) {
+		return false
+	}
+	for i := range s.vals {
+		if s.vals[i] != o.vals[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// absState is the machine state on entry to an instruction
+type absState struct {
+	data        absStack
+	ip          absStack
+	speculative bool // Reached only by guessing targets of computed jumps
+}
+
+func (s absState) merge(o absState) absState {
+	return absState{
+		data:        s.data.merge(o.data),
+		ip:          s.ip.merge(o.ip),
+		speculative: s.speculative && o.speculative,
+	}
+}
+
+func (s absState) equal(o absState) bool {
+	return s.data.equal(o.data) && s.ip.equal(o.ip) && s.speculative == o.speculative
+}
+
+// unknownState is the state at an address reached with unknown stacks
+func unknownState(speculative bool) absState {
+	return absState{
+		data:        absStack{floor: true},
+		ip:          absStack{floor: true},
+		speculative: speculative,
+	}
+}
+
+// jumpTarget records a constant control transfer for boundary checking
+type jumpTarget struct {
+	from int32
+	op   Op
+	to   int32
+}
+
+// verifier holds the state of one Verify run
+type verifier struct {
+	image    []int32
+	code     []Region // Code sections, or nil if the whole image is code
+	states   map[int32]absState
+	decoded  map[int32]Instruction
+	work     []int32
+	problems map[Problem]bool
+	targets  []jumpTarget
+	dataRefs map[int32]bool
+
+	// Addresses that computed jumps and returns may reach
+	pushed      map[int32]bool
+	returnSites map[int32]bool
+	computed    bool // A jump with an unknown target was seen
+	dynReturn   bool // A return with an unknown address was seen
+}
+
+// Verify checks a program image before execution, following control flow
+// from address zero. It decodes every reachable instruction, flags unknown
+// opcodes, truncated immediates, stack underflows, constant jump targets
+// that do not land on an instruction inside the image and instructions
+// that run past the end of the code, and computes the worst-case data
+// stack depth where control flow is static.
+//
+// Constant jump targets are found by tracking constants on the stacks, so
+// the PUSH label / JMP idiom of compiled code and PUSHIP / POPIP returns are
+// followed precisely. Where a target is computed at run time, every
+// constant that addresses the image is assumed to be a possible target.
+func Verify(image []int32) *Report {
+	return VerifyAt(image, 0)
+}
+
+// VerifyAt is like Verify but follows control flow from entry
+func VerifyAt(image []int32, entry int32) *Report {
+	return verify(image, entry, nil)
+}
+
+// Verify checks the loaded program like VerifyAt, from its entry point.
+// Control flow that leaves its code sections is reported as running past
+// the end of the code.
+func (m *VM) Verify() *Report {
+	var code []Region
+	for _, r := range m.regions {
+		if r.Kind == SectionCode {
+			code = append(code, r)
+		}
+	}
+	return verify(m.Image(), m.entry, code)
+}
+
+func verify(image []int32, entry int32, code []Region) *Report {
+	v := &verifier{
+		image:       image,
+		code:        code,
+		states:      make(map[int32]absState),
+		decoded:     make(map[int32]Instruction),
+		problems:    make(map[Problem]bool),
+		dataRefs:    make(map[int32]bool),
+		pushed:      make(map[int32]bool),
+		returnSites: make(map[int32]bool),
+	}
+
+	if v.isCode(entry) {
+		v.visit(entry, absState{})
+	} else {
+		v.problem(entry, "entry 0x%x is outside the code", entry)
+	}
+	for {
+		v.run()
+
+		// Computed jumps and returns may go to any recorded candidate
+		changed := false
+		if v.computed {
+			for addr := range v.pushed {
+				changed = v.visit(addr, unknownState(true)) || changed
+			}
+		}
+		if v.dynReturn {
+			for addr := range v.returnSites {
+				changed = v.visit(addr, unknownState(false)) || changed
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	return v.report()
+}
+
+// visit merges state into the state at addr and queues addr if it changed
+func (v *verifier) visit(addr int32, state absState) bool {
+	old, seen := v.states[addr]
+	if seen {
+		state = old.merge(state)
+		if state.equal(old) {
+			return false
+		}
+	}
+	v.states[addr] = state
+	v.work = append(v.work, addr)
+	return true
+}
+
+// isCode reports whether addr is a word address in the code of the image
+func (v *verifier) isCode(addr int32) bool {
+	if !IsCodeAddress(v.image, addr) {
+		return false
+	}
+	if v.code == nil {
+		return true
+	}
+	for _, r := range v.code {
+		if addr >= r.Addr && addr < r.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// fallThrough visits the instruction following in, which must be code
+func (v *verifier) fallThrough(in Instruction, state absState) {
+	if !v.isCode(in.Next()) {
+		if !state.speculative {
+			v.problem(in.Addr, "%s runs past the end of the code", in.Op)
+		}
+		return
+	}
+	v.visit(in.Next(), state)
+}
+
+func (v *verifier) problem(addr int32, format string, args ...interface{}) {
+	v.problems[Problem{Addr: addr, Msg: fmt.Sprintf(format, args...)}] = true
+}
+
+// jump follows a control transfer to a possibly unknown target
+func (v *verifier) jump(in Instruction, target absValue, state absState) {
+	if !target.known {
+		v.computed = true
+		return
+	}
+
+	v.targets = append(v.targets, jumpTarget{from: in.Addr, op: in.Op, to: target.n})
+	if IsCodeAddress(v.image, target.n) {
+		v.visit(target.n, state)
+	}
+}
+
+// returnSite records the address after a call as a place returns may go
+// to. Calls decoded only by guessing, which may be data, are left out.
+func (v *verifier) returnSite(in Instruction, state absState) {
+	if !state.speculative {
+		v.returnSites[in.Next()] = true
+	}
+}
+
+func (v *verifier) run() {
+	for len(v.work) > 0 {
+		addr := v.work[len(v.work)-1]
+		v.work = v.work[:len(v.work)-1]
+		v.step(addr, v.states[addr])
+	}
+}
+
+// step interprets the instruction at addr abstractly and visits its
+// successors
+func (v *verifier) step(addr int32, state absState) {
+	in, ok := Decode(v.image, addr)
+	if !ok {
+		if IsCodeAddress(v.image, addr) && !state.speculative {
+			v.problem(addr, "truncated %s immediate", in.Op)
+		}
+		return
+	}
+	if in.Op < NOP || in.Op >= NOP_END {
+		if !state.speculative {
+			v.problem(addr, "unknown opcode %d", in.Op)
+		}
+		return
+	}
+	v.decoded[addr] = in
+
+	data := state.data.clone()
+	ip := state.ip.clone()
+	underflow := false
+	pop := func() absValue {
+		val, ok := data.pop()
+		if !ok {
+			underflow = true
+			data.floor = true
+		}
+		return val
+	}
+	popIP := func() absValue {
+		val, ok := ip.pop()
+		if !ok {
+			v.problem(addr, "%s with empty IP stack", in.Op)
+			ip.floor = true
+		}
+		return val
+	}
+	next := func() absState {
+		return absState{data: data, ip: ip, speculative: state.speculative}
+	}
+	defer func() {
+		if underflow && !state.speculative {
+			v.problem(addr, "%s pops empty stack", in.Op)
+		}
+	}()
+
+	switch in.Op {
+	case PUSH:
+		data.push(absValue{known: true, n: in.Operand})
+		if IsCodeAddress(v.image, in.Operand) {
+			v.pushed[in.Operand] = true
+		}
+	case PUSHIP:
+		ip.push(absValue{known: true, n: in.Operand})
+		if IsCodeAddress(v.image, in.Operand) {
+			v.returnSites[in.Operand] = true
+		}
+	case DROPIP:
+		popIP()
+	case ADD, SUB:
+		b, a := pop(), pop()
+		sum := absValue{known: a.known && b.known}
+		if in.Op == ADD {
+			sum.n = a.n + b.n
+		} else {
+			sum.n = b.n - a.n
+		}
+		data.push(sum)
+	case DUP:
+		a := pop()
+		data.push(a)
+		data.push(a)
+	case SWAP:
+		b, a := pop(), pop()
+		data.push(b)
+		data.push(a)
+	case ROL3:
+		c, b, a := pop(), pop(), pop()
+		data.push(b)
+		data.push(c)
+		data.push(a)
+	case TRY:
+		// Handlers are entered after unwinding, with the thrown code pushed
+		v.jump(in, absValue{known: true, n: in.Operand}, unknownState(state.speculative))
+	case JMP:
+		target := pop()
+		if target.known && target.n == addr {
+			return // Halt
+		}
+		v.jump(in, target, next())
+		return
+	case JZ, JNZ:
+		pop()
+		target := pop()
+		v.jump(in, target, next())
+	case CALL:
+		ip.push(absValue{known: true, n: in.Next()})
+		v.returnSite(in, state)
+		v.jump(in, absValue{known: true, n: in.Operand}, next())
+		return
+	case CALLS:
+		target := pop()
+		ip.push(absValue{known: true, n: in.Next()})
+		v.returnSite(in, state)
+		v.jump(in, target, next())
+		return
+	case POPIP, RET:
+		target := popIP()
+		if !target.known {
+			v.dynReturn = true
+			return
+		}
+		v.jump(in, target, next())
+		return
+	case LOAD, STOR:
+		addr := pop()
+		if in.Op == STOR {
+			pop()
+		} else {
+			data.push(absValue{})
+		}
+		if addr.known && IsCodeAddress(v.image, addr.n) && !state.speculative {
+			v.dataRefs[addr.n] = true
+		}
+	case THROW, EXIT:
+		pop()
+		return
+	default:
+		pops, pushes := in.Op.StackEffect()
+		for i := 0; i < pops; i++ {
+			pop()
+		}
+		for i := 0; i < pushes; i++ {
+			data.push(absValue{})
+		}
+	}
+
+	v.fallThrough(in, next())
+}
+
+// report checks jump targets against instruction boundaries and summarizes
+// the analysis
+func (v *verifier) report() *Report {
+	// Words holding immediates are not instruction boundaries
+	operands := make(map[int32]int32)
+	for addr, in := range v.decoded {
+		if in.Op.HasOperand() {
+			operands[addr+4] = addr
+		}
+	}
+
+	for _, t := range v.targets {
+		if v.states[t.from].speculative {
+			continue
+		}
+		switch {
+		case t.to < 0 || int(t.to/4) >= len(v.image):
+			v.problem(t.from, "%s target 0x%x outside image", t.op, t.to)
+		case t.to%4 != 0:
+			v.problem(t.from, "%s target 0x%x is not word aligned", t.op, t.to)
+		default:
+			if owner, ok := operands[t.to]; ok {
+				v.problem(t.from, "%s target 0x%x is inside the instruction at 0x%x", t.op, t.to, owner)
+			}
+		}
+	}
+
+	r := &Report{Static: true}
+
+	targets := make(map[int32]bool)
+	for _, t := range v.targets {
+		if IsCodeAddress(v.image, t.to) && !v.states[t.from].speculative {
+			targets[t.to] = true
+		}
+	}
+	r.Targets = sortedAddrs(targets)
+	r.DataRefs = sortedAddrs(v.dataRefs)
+
+	for p := range v.problems {
+		r.Problems = append(r.Problems, p)
+	}
+	sort.Slice(r.Problems, func(i, j int) bool {
+		if r.Problems[i].Addr != r.Problems[j].Addr {
+			return r.Problems[i].Addr < r.Problems[j].Addr
+		}
+		return r.Problems[i].Msg < r.Problems[j].Msg
+	})
+
+	for addr, in := range v.decoded {
+		state := v.states[addr]
+		if state.speculative {
+			continue
+		}
+		r.Instructions++
+		if state.data.floor {
+			r.Static = false
+			continue
+		}
+
+		pops, pushes := in.Op.StackEffect()
+		depth := len(state.data.vals) + pushes - pops
+		if depth > r.MaxDepth {
+			r.MaxDepth = depth
+		}
+		if len(state.data.vals) > r.MaxDepth {
+			r.MaxDepth = len(state.data.vals)
+		}
+	}
+
+	return r
+}
+
+// sortedAddrs returns the addresses in a set in ascending order
+func sortedAddrs(set map[int32]bool) []int32 {
+	addrs := make([]int32, 0, len(set))
+	for addr := range set {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i] < addrs[j]
+	})
+	return addrs
+}
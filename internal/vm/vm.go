@@ -2,6 +2,7 @@ package vm
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -15,14 +16,29 @@ type ErrorCallback func(msg string)
 type VM struct {
 	stack     []int32       // Data stack
 	stackIP   []int32       // Instruction pointer stack
+	frames    []int32       // Frame stack holding saved frame pointers and locals
+	fp        int32         // Frame pointer, index of slot 0 of the current frame
 	labels    []Label       // Code labels
 	memSize   int           // Memory size in words
 	memory    []int32       // VM memory
 	ip        int32         // Instruction pointer
-	in        *bufio.Reader // Input stream
+	entry     int32         // Address execution starts at
+	debug     []byte        // Debug information saved with the image
+	format    int           // Container version of the loaded image, 0 if headerless
+	regions   []Region      // Code, data and bss sections of the program
+	lines     []SourceLine  // Line table mapping addresses to source lines
+	instr     int32         // Address of the instruction being executed
+	steps     uint64        // Number of instructions executed
+	in        io.ByteReader // Input stream
 	out       io.Writer     // Output stream
 	running   bool          // VM running state
+	exitCode  int           // Exit status set by EXIT
 	errorFunc ErrorCallback // Error callback function
+
+	handlers     []Handler // Exception handler stack
+	trapFaults   bool      // Turn runtime faults into throws
+	faultPending bool      // A trapped fault awaits throwing
+	faultCode    int32     // Code of the pending fault
 }
 
 // NewMachine creates a new machine instance with default settings
@@ -35,11 +51,13 @@ func NewMachineWithSize(memorySize int, out io.Writer, in io.Reader, errorCallba
 	m := &VM{
 		stack:     make([]int32, 0),
 		stackIP:   make([]int32, 0),
+		frames:    make([]int32, 0),
 		labels:    make([]Label, 0),
+		handlers:  make([]Handler, 0),
 		memSize:   memorySize,
 		memory:    make([]int32, memorySize),
 		ip:        0,
-		in:        bufio.NewReader(in),
+		in:        byteReader(in),
 		out:       out,
 		running:   true,
 		errorFunc: errorCallback,
@@ -53,19 +71,33 @@ func (m *VM) Clone(errorCallback ErrorCallback) *VM {
 	clone := &VM{
 		stack:     make([]int32, len(m.stack)),
 		stackIP:   make([]int32, len(m.stackIP)),
+		frames:    make([]int32, len(m.frames)),
+		fp:        m.fp,
 		labels:    make([]Label, len(m.labels)),
+		handlers:  make([]Handler, len(m.handlers)),
 		memSize:   m.memSize,
 		memory:    make([]int32, m.memSize),
 		ip:        m.ip,
+		entry:     m.entry,
+		debug:     m.debug,
+		format:    m.format,
+		regions:   m.regions,
+		lines:     m.lines,
+		instr:     m.instr,
+		steps:     m.steps,
 		in:        m.in,
 		out:       m.out,
 		running:   m.running,
 		errorFunc: errorCallback,
+
+		trapFaults: m.trapFaults,
 	}
 
 	copy(clone.stack, m.stack)
 	copy(clone.stackIP, m.stackIP)
+	copy(clone.frames, m.frames)
 	copy(clone.labels, m.labels)
+	copy(clone.handlers, m.handlers)
 	copy(clone.memory, m.memory)
 
 	return clone
@@ -78,7 +110,17 @@ func (m *VM) Reset() {
 		m.memory[i] = int32(NOP)
 	}
 	m.stack = m.stack[:0] // Clear stack
+	m.frames = m.frames[:0]
+	m.fp = 0
+	m.handlers = m.handlers[:0]
+	m.faultPending = false
 	m.ip = 0
+	m.steps = 0
+}
+
+// SetErrorCallback replaces the error callback
+func (m *VM) SetErrorCallback(errorCallback ErrorCallback) {
+	m.errorFunc = errorCallback
 }
 
 // Error reports an error via the error callback
@@ -101,7 +143,7 @@ func (m *VM) PushIP(n int32) {
 // PopIP pops a value from the instruction pointer stack
 func (m *VM) PopIP() int32 {
 	if len(m.stackIP) == 0 {
-		m.Error("POP empty IP stack")
+		m.Fault(FaultIPStackUnderflow, "POP empty IP stack")
 		return 0
 	}
 	n := m.stackIP[len(m.stackIP)-1]
@@ -112,7 +154,7 @@ func (m *VM) PopIP() int32 {
 // Pop pops a value from the data stack
 func (m *VM) Pop() int32 {
 	if len(m.stack) == 0 {
-		m.Error("POP empty stack")
+		m.Fault(FaultStackUnderflow, "POP empty stack")
 		return 0
 	}
 	n := m.stack[len(m.stack)-1]
@@ -120,10 +162,15 @@ func (m *VM) Pop() int32 {
 	return n
 }
 
+// Stack returns a copy of the data stack, top last
+func (m *VM) Stack() []int32 {
+	return append([]int32{}, m.stack...)
+}
+
 // CheckBounds checks if an address is within memory bounds
 func (m *VM) CheckBounds(n int32, msg string) bool {
 	if n < 0 || int(n) >= m.memSize {
-		m.Error(msg)
+		m.Fault(FaultOutOfBounds, msg)
 		return false
 	}
 	return true
@@ -161,16 +208,18 @@ func (m *VM) LoadInt(n int32) {
 	m.Next()
 }
 
-// Run executes the program from a given address
+// Run executes the program from a given address and returns its exit
+// status, which is zero unless the program stops with EXIT
 func (m *VM) Run(startAddr int32) int {
 	m.ip = startAddr
 	m.running = true
+	m.exitCode = 0
 
 	for m.running {
 		m.Exec(Op(m.memory[m.ip]))
 	}
 
-	return 0 // Exit code
+	return m.exitCode
 }
 
 // Size returns the size of the program in memory
@@ -231,7 +280,22 @@ func (m *VM) SetOutput(out io.Writer) {
 
 // SetInput sets the input reader
 func (m *VM) SetInput(in io.Reader) {
-	m.in = bufio.NewReader(in)
+	m.in = byteReader(in)
+}
+
+// byteReader reads single bytes from in, buffering it unless it already
+// reads byte by byte
+func byteReader(in io.Reader) io.ByteReader {
+	if br, ok := in.(io.ByteReader); ok {
+		return br
+	}
+	return bufio.NewReader(in)
+}
+
+// Steps returns the number of instructions executed so far. While an
+// instruction executes, it is that instruction's 1-based step number.
+func (m *VM) Steps() uint64 {
+	return m.steps
 }
 
 // SetMem sets a memory value at a specific address
@@ -261,24 +325,36 @@ func (m *VM) LoadHalt() {
 	m.Load(JMP)
 }
 
-// LoadImage loads a program image from a reader
+// LoadImage loads a program image from a reader. Images in the container
+// format are recognized by their magic number; anything else is read as a
+// legacy headerless sequence of little-endian words loaded at address zero.
 func (m *VM) LoadImage(r io.Reader) error {
 	m.Reset()
-	buf := make([]byte, 4)
+	m.labels = m.labels[:0]
+	m.entry = 0
+	m.debug = nil
+	m.format = 0
+	m.regions = nil
+	m.lines = nil
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
 
-	for {
-		n, err := r.Read(buf)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
+	if bytes.HasPrefix(data, []byte(ImageMagic)) {
+		if err := m.loadContainer(data); err != nil {
 			return err
 		}
-		if n != 4 {
-			return fmt.Errorf("incomplete read: got %d bytes, expected 4", n)
-		}
+		m.ip = 0
+		return nil
+	}
 
-		op := int32(buf[0]) | int32(buf[1])<<8 | int32(buf[2])<<16 | int32(buf[3])<<24
+	if n := len(data) % 4; n != 0 {
+		return fmt.Errorf("incomplete read: got %d bytes, expected 4", n)
+	}
+	for i := 0; i < len(data); i += 4 {
+		op := int32(data[i]) | int32(data[i+1])<<8 | int32(data[i+2])<<16 | int32(data[i+3])<<24
 		m.Load(Op(op))
 	}
 
@@ -286,8 +362,14 @@ func (m *VM) LoadImage(r io.Reader) error {
 	return nil
 }
 
-// SaveImage saves the program to a writer
+// SaveImage saves the program to a writer in the container format, with
+// its entry address, labels and debug information
 func (m *VM) SaveImage(w io.Writer) error {
+	return m.saveContainer(w)
+}
+
+// SaveRawImage saves the program as legacy headerless words
+func (m *VM) SaveRawImage(w io.Writer) error {
 	size := m.Size()
 	buf := make([]byte, 4)
 
@@ -308,6 +390,9 @@ func (m *VM) SaveImage(w io.Writer) error {
 
 // Exec executes a single instruction
 func (m *VM) Exec(op Op) {
+	m.steps++
+	m.instr = m.ip
+
 	switch op {
 	case NOP:
 		m.InstrNOP()
@@ -357,8 +442,36 @@ func (m *VM) Exec(op Op) {
 		m.InstrRol3()
 	case OUTNUM:
 		m.InstrOutNum()
+	case TRY:
+		m.InstrTry()
+	case ENDTRY:
+		m.InstrEndTry()
+	case THROW:
+		m.InstrThrow()
+	case CALL:
+		m.InstrCall()
+	case CALLS:
+		m.InstrCallS()
+	case RET:
+		m.InstrRet()
+	case EXIT:
+		m.InstrExit()
+	case ENTER:
+		m.InstrEnter()
+	case LEAVE:
+		m.InstrLeave()
+	case LOADL:
+		m.InstrLoadL()
+	case STORL:
+		m.InstrStorL()
 	default:
-		m.Error(fmt.Sprintf("Unknown instruction: %d", op))
+		m.Fault(FaultUnknownOp, fmt.Sprintf("Unknown instruction: %d", op))
+	}
+
+	// A trapped fault unwinds once the faulting instruction has finished
+	if m.faultPending {
+		m.faultPending = false
+		m.Throw(m.faultCode)
 	}
 }
 
@@ -421,6 +534,9 @@ func (m *VM) InstrCompl() {
 func (m *VM) InstrIn() {
 	b, err := m.in.ReadByte()
 	if err != nil {
+		if err != io.EOF {
+			m.Error("IN: " + err.Error())
+		}
 		m.Push(0) // EOF or error
 	} else {
 		m.Push(int32(b))
@@ -500,6 +616,48 @@ func (m *VM) InstrPopIP() {
 	}
 }
 
+func (m *VM) InstrCall() {
+	m.Next()
+	addr := m.memory[m.ip]
+	if m.CheckBounds(addr, "CALL") {
+		m.PushIP(m.ip + 4)
+		m.ip = addr
+	} else {
+		m.Next()
+	}
+}
+
+func (m *VM) InstrCallS() {
+	addr := m.Pop()
+	if m.CheckBounds(addr, "CALLS") {
+		m.PushIP(m.ip + 4)
+		m.ip = addr
+	} else {
+		m.Next()
+	}
+}
+
+func (m *VM) InstrRet() {
+	addr := m.PopIP()
+	if m.CheckBounds(addr, "RET") {
+		m.ip = addr
+	} else {
+		m.Next()
+	}
+}
+
+func (m *VM) InstrExit() {
+	status := m.Pop()
+	if status&0xff == FaultStatus {
+		m.Fault(FaultExitStatus, fmt.Sprintf("EXIT status %d is reserved for runtime faults", status))
+		status = FaultStatus
+	}
+	m.exitCode = int(status)
+	if !m.faultPending {
+		m.running = false
+	}
+}
+
 func (m *VM) InstrDropIP() {
 	m.PopIP()
 	m.Next()
@@ -556,3 +714,78 @@ func (m *VM) InstrRol3() {
 	m.Push(a)
 	m.Next()
 }
+
+func (m *VM) InstrTry() {
+	m.Next()
+	m.handlers = append(m.handlers, Handler{
+		Addr:    m.memory[m.ip],
+		StackSP: len(m.stack),
+		IPSP:    len(m.stackIP),
+		FrameSP: len(m.frames),
+		FP:      m.fp,
+	})
+	m.Next()
+}
+
+func (m *VM) InstrEndTry() {
+	if len(m.handlers) == 0 {
+		m.Error("ENDTRY without TRY")
+	} else {
+		m.handlers = m.handlers[:len(m.handlers)-1]
+	}
+	m.Next()
+}
+
+func (m *VM) InstrThrow() {
+	m.Throw(m.Pop())
+}
+
+func (m *VM) InstrEnter() {
+	m.Next()
+	n := m.memory[m.ip]
+	if n < 0 {
+		m.Fault(FaultFrame, "ENTER negative frame size")
+	} else {
+		m.frames = append(m.frames, m.fp)
+		m.fp = int32(len(m.frames))
+		m.frames = append(m.frames, make([]int32, n)...)
+	}
+	m.Next()
+}
+
+func (m *VM) InstrLeave() {
+	if m.fp == 0 {
+		m.Fault(FaultFrame, "LEAVE without frame")
+	} else {
+		saved := m.frames[m.fp-1]
+		m.frames = m.frames[:m.fp-1]
+		m.fp = saved
+	}
+	m.Next()
+}
+
+// local returns the frame stack index of local slot i, or -1 if out of frame
+func (m *VM) local(i int32, msg string) int32 {
+	if m.fp == 0 || i < 0 || int(m.fp+i) >= len(m.frames) {
+		m.Fault(FaultFrame, msg)
+		return -1
+	}
+	return m.fp + i
+}
+
+func (m *VM) InstrLoadL() {
+	m.Next()
+	if n := m.local(m.memory[m.ip], "LOADL"); n != -1 {
+		m.Push(m.frames[n])
+	}
+	m.Next()
+}
+
+func (m *VM) InstrStorL() {
+	m.Next()
+	val := m.Pop()
+	if n := m.local(m.memory[m.ip], "STORL"); n != -1 {
+		m.frames[n] = val
+	}
+	m.Next()
+}
@@ -0,0 +1,40 @@
+package vm
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+// countdown loads a loop that counts n down to zero and halts
+func countdown(m *VM, n int32) {
+	m.Load(PUSH)
+	m.LoadInt(n)
+	loop := m.Pos()
+	m.Load(PUSH)
+	m.LoadInt(-1)
+	m.Load(ADD)
+	m.Load(DUP)
+	m.Load(PUSH)
+	m.LoadInt(loop)
+	m.Load(SWAP)
+	m.Load(JNZ)
+	m.Load(DROP)
+	m.LoadHalt()
+}
+
+// BenchmarkRun measures the dispatch loop on a loop of arithmetic, stack
+// and jump instructions
+func BenchmarkRun(b *testing.B) {
+	m := NewMachineWithSize(1024, io.Discard, strings.NewReader(""), func(msg string) {
+		b.Fatal(msg)
+	})
+	countdown(m, 100000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Run(0)
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(m.Steps())/float64(b.N), "instrs/op")
+}
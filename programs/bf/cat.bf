@@ -0,0 +1,2 @@
+Copy input to output until the end of input
+,[.,]
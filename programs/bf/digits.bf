@@ -0,0 +1,5 @@
+Print the digits 0 to 9 and a newline
+>++++++++[<++++++>-]<     cell 0 = 48 (the digit zero)
+>++++++++++               cell 1 = 10 (the count)
+[<.+>-]                   print and increment cell 0 ten times
+++++++++++.               print a newline
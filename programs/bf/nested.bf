@@ -0,0 +1,13 @@
+Count down from 255 in loops nested three deep and print a star for each
+of the outer iterations in two lines of ten; the inner cells wrap around
+from 0 to 255
+++++++++++ ++++++++++ ++++++++++ ++++++++++ ++ cell 0 = 42 (the star)
+>++                                          cell 1 = 2 (the lines)
+[
+  >++++++++++[                               cell 2 = 10 (stars in a line)
+    >-[>-[->+<]<-]                           cells 3 and 4 count down from 255
+    <<<.>>-
+  ]
+  ++++++++++.[-]                             newline
+  <-
+]
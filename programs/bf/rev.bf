@@ -0,0 +1,3 @@
+Print the input backwards
+>,[>,]      read every byte into its own cell up to the end of input
+<[.<]       print the cells from the last back to the first
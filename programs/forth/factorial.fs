@@ -0,0 +1,6 @@
+\ Recursive factorials of 0 to 12
+: fact ( n -- n! )
+  dup 2 < if drop 1 exit then
+  dup 1- recurse * ;
+: table ( -- ) 13 0 do i . ." ! = " i fact . cr loop ;
+table
@@ -0,0 +1,3 @@
+\ The first 20 Fibonacci numbers, with the pair kept on the stack
+: fibs ( n -- ) 0 1 rot 0 do over . tuck + loop 2drop cr ;
+20 fibs
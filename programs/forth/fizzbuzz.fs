@@ -0,0 +1,10 @@
+\ FizzBuzz from 1 to 30
+: fizz? ( n -- flag ) 3 mod 0= ;
+: buzz? ( n -- flag ) 5 mod 0= ;
+: fizzbuzz ( n -- )
+  dup fizz? over buzz? and if drop ." FizzBuzz" exit then
+  dup fizz? if drop ." Fizz" exit then
+  dup buzz? if drop ." Buzz" exit then
+  . ;
+: run ( -- ) 31 1 do i fizzbuzz cr loop ;
+run
@@ -0,0 +1,9 @@
+\ Greatest common divisors by Euclid's algorithm, and least common
+\ multiples from them
+: gcd ( a b -- n ) begin ?dup while tuck mod repeat ;
+: lcm ( a b -- n ) 2dup gcd / * ;
+: show ( a b -- ) over . dup . ." gcd " 2dup gcd . ." lcm " lcm . cr ;
+48 18 show
+17 5 show
+1071 462 show
+100 75 show
@@ -0,0 +1,15 @@
+\ Towers of Hanoi with three discs. The pegs of a move are kept on the
+\ return stack while the smaller discs are moved out of the way.
+variable moves
+: move ( from to -- ) ." move " swap . ." to " . cr 1 moves +! ;
+: via ( from to -- peg ) + 6 swap - ;
+: hanoi ( n from to -- )
+  rot ?dup 0= if 2drop exit then    \ from to n
+  1- -rot 2dup >r >r                \ n-1 from to      R: to from
+  2dup via nip                      \ n-1 from via
+  rot dup >r -rot recurse           \                  R: to from n-1
+  r> r> r>                          \ n-1 from to
+  2dup move
+  tuck via swap recurse ;           \ n-1 via to
+3 1 3 hanoi
+moves @ . ." moves" cr
@@ -0,0 +1,3 @@
+\ The classic first program
+: hello ( -- ) ." Hello, World!" cr ;
+hello
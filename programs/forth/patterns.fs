@@ -0,0 +1,8 @@
+\ Triangles drawn with nested loops
+: stars ( n -- ) 0 do 42 emit loop ;
+: triangle ( n -- ) 1+ 1 do i stars cr loop ;
+: pyramid ( n -- ) dup 0 do dup i - 1- spaces i 2* 1+ stars cr loop drop ;
+: box ( w h -- ) 0 do dup 0 do i j + 2 mod if [char] . else [char] # then emit loop cr loop drop ;
+4 triangle
+4 pyramid
+6 3 box
@@ -0,0 +1,15 @@
+\ The sieve of Eratosthenes, printing the primes below 100
+100 constant size
+create flags size cells allot
+variable primes
+
+: flag ( n -- addr ) cells flags + ;
+: clear ( -- ) size 0 do 0 i flag ! loop ;
+: strike ( n -- ) dup dup * begin dup size < while true over flag ! over + repeat 2drop ;
+: sieve ( -- )
+  clear 0 primes !
+  size 2 do
+    i flag @ 0= if i . 1 primes +! i strike then
+  loop cr ;
+sieve
+primes @ . ." primes" cr
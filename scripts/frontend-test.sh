@@ -0,0 +1,52 @@
+#!/bin/bash
+# frontend-test.sh - Compile the programs of the MiniC front-end in
+# programs/minic and compare their output with the expected .out files. The
+# Forth and Brainfuck programs are checked by go test ./internal/forth and
+# ./internal/bf. A program with a .in file of the same name
+# reads it as input.
+
+set -e
+
+# Colors for output
+RED='\033[0;31m'
+GREEN='\033[0;32m'
+BLUE='\033[0;34m'
+NC='\033[0m' # No Color
+
+ROOT="$(cd "$(dirname "$0")/.." && pwd)"
+WORK="$(mktemp -d)"
+trap 'rm -rf "$WORK"' EXIT
+
+echo -e "${BLUE}Building smg...${NC}"
+(cd "$ROOT" && go build -o "$WORK/smg" .)
+
+PASSED=0
+FAILED=0
+
+# check compiles each program of a directory with a front-end command and
+# runs it
+check() {
+    local command="$1" dir="$2" ext="$3"
+    for src in "$ROOT/programs/$dir"/*."$ext"; do
+        name="$(basename "$src" ."$ext")"
+        input=/dev/null
+        if [[ -f "$ROOT/programs/$dir/$name.in" ]]; then
+            input="$ROOT/programs/$dir/$name.in"
+        fi
+        cp "$src" "$WORK/$name.$ext"
+        if "$WORK/smg" "$command" "$WORK/$name.$ext" > /dev/null &&
+            "$WORK/smg" run "$WORK/$name.bin" < "$input" > "$WORK/$name.txt" &&
+            diff -u "$ROOT/programs/$dir/$name.out" "$WORK/$name.txt"; then
+            echo -e "${GREEN}PASS${NC} $dir/$name"
+            PASSED=$((PASSED + 1))
+        else
+            echo -e "${RED}FAIL${NC} $dir/$name"
+            FAILED=$((FAILED + 1))
+        fi
+    done
+}
+
+check minic minic mc
+
+echo -e "${BLUE}$PASSED passed, $FAILED failed${NC}"
+[[ $FAILED -eq 0 ]]